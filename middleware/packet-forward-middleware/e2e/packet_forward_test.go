@@ -57,6 +57,13 @@ func TestPacketForwardMiddleware(t *testing.T) {
 
 	baseCfg.ChainID = chainIdB
 	configB := baseCfg
+	// Chain B forwards the a->b->a case below, which strict loop detection would reject.
+	configB.ModifyGenesis = cosmos.ModifyGenesis([]cosmos.GenesisKV{
+		{
+			Key:   "app_state.packetfowardmiddleware.params.loop_detection",
+			Value: "LOOP_DETECTION_ALLOW",
+		},
+	})
 
 	baseCfg.ChainID = chainIdC
 	configC := baseCfg
@@ -0,0 +1,198 @@
+package packetforward
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// onRecvAdapterPacket forwards a packet of an application other than ICS-20 through its packet data adapter,
+// following the same forward metadata semantics as fungible token transfers. Packets forwarded through an
+// adapter are not subject to forward fees or rate limits and cannot be split.
+func (im IBCMiddleware) onRecvAdapterPacket(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	logger := im.keeper.Logger(ctx)
+
+	data, err := adapter.ParsePacketData(packet.GetData())
+	if err != nil {
+		logger.Debug(fmt.Sprintf("packetForwardMiddleware OnRecvPacket payload is not handled by the packet data adapter: %s", err.Error()))
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	logger.Debug("packetForwardMiddleware OnRecvPacket",
+		"sequence", packet.Sequence,
+		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
+		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
+		"memo", data.GetMemo(),
+	)
+
+	// bound the memo before it is decoded, memos that clearly hold no forward metadata are left to the
+	// underlying application.
+	if err := im.keeper.GetParams(ctx).ValidateMemoSize(data.GetMemo()); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket memo is too large", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	d := make(map[string]interface{})
+	err = json.Unmarshal([]byte(data.GetMemo()), &d)
+	if err != nil || d["forward"] == nil {
+		// not a packet that should be forwarded
+		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	m := &types.PacketMetadata{}
+	err = json.Unmarshal([]byte(data.GetMemo()), m)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error parsing forward metadata", "error", err)
+		return newErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
+	}
+
+	metadata := m.Forward
+
+	processed := getBoolFromAny(ctx.Context().Value(types.ProcessedKey{}))
+
+	if err := metadata.Validate(); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	if len(metadata.Splits) > 0 {
+		err := errors.New("splits are only supported for ICS-20 transfers")
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	if metadata.Handler != "" {
+		err := errors.New("forward handlers are only supported for ICS-20 transfers")
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	params := im.keeper.GetParams(ctx)
+	if err := params.ValidateForwardDepth(metadata); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is too deep", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	if !params.IsForwardRouteAllowed(packet.DestinationChannel, metadata.Channel) {
+		err := errorsmod.Wrapf(types.ErrForwardRouteNotAllowed, "%s -> %s", packet.DestinationChannel, metadata.Channel)
+		logger.Error("packetForwardMiddleware OnRecvPacket forward route is not allowed", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	if params.LoopDetection == types.LoopDetectionStrict {
+		// the denom traces of other applications are not known, only the chain the packet was received from is checked.
+		if err := im.keeper.DetectForwardLoop(ctx, packet, "", metadata); err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket forward loop detected", "error", err)
+			return newErrorAcknowledgement(err)
+		}
+	}
+
+	// override the receiver so that senders cannot move assets through arbitrary addresses.
+	overrideReceiver, err := GetReceiver(packet.DestinationChannel, data.GetSender())
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket failed to construct override receiver", "error", err)
+		return newErrorAcknowledgement(fmt.Errorf("failed to construct override receiver: %w", err))
+	}
+
+	if !processed {
+		overrideDataBz, err := adapter.ReceivePacketData(data, overrideReceiver)
+		if err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket error overriding packet data", "error", err)
+			return newErrorAcknowledgement(fmt.Errorf("error overriding packet data: %w", err))
+		}
+
+		if err := im.receiveOverridePacket(ctx, packet, overrideDataBz, relayer); err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket error receiving packet", "error", err)
+			return newErrorAcknowledgement(fmt.Errorf("error receiving packet: %w", err))
+		}
+	}
+
+	retries, timeout, backoff, err := im.retryOptions(metadata, params)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error parsing retry backoff", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	err = im.keeper.ForwardAdapterPacket(ctx, adapter, nil, packet, data, overrideReceiver, metadata, retries, timeout, backoff)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
+	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
+	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
+	return nil
+}
+
+// onAcknowledgementAdapterPacket handles the acknowledgement of a packet of an application other than ICS-20.
+func (im IBCMiddleware) onAcknowledgementAdapterPacket(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	inFlightPacket := im.keeper.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if inFlightPacket != nil {
+		// this is a forwarded packet, so override handling to avoid refund from being processed.
+		return im.keeper.WriteAcknowledgementForForwardedAdapterPacket(ctx, adapter, packet, inFlightPacket, ack)
+	}
+
+	if im.keeper.AcknowledgeForceRefundedPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence, ack) {
+		// the inbound packet was already refunded by the authority.
+		return nil
+	}
+
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// onTimeoutAdapterPacket handles the timeout of a packet of an application other than ICS-20.
+func (im IBCMiddleware) onTimeoutAdapterPacket(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, err := im.keeper.TimeoutShouldRetry(ctx, packet)
+	if inFlightPacket != nil {
+		// the timed out packet is resolved either way, a retry is stored under its new sequence.
+		im.keeper.RemoveInFlightPacket(ctx, packet)
+		if err != nil {
+			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
+			return im.keeper.WriteAcknowledgementForForwardedAdapterPacket(ctx, adapter, packet, inFlightPacket, newErrorAcknowledgement(err))
+		}
+		// timeout should be retried. In order to do that, we need to handle this timeout to refund on this chain first.
+		if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+			return err
+		}
+		return im.keeper.RetryAdapterTimeout(ctx, adapter, packet, inFlightPacket)
+	}
+
+	if im.keeper.ClearForceRefundedPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence) {
+		// the inbound packet was already refunded by the authority, the assets must not be refunded again.
+		return nil
+	}
+
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -1,16 +1,33 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const (
+	FlagRefundChannel     = "refund-channel"
+	FlagOriginalSender    = "original-sender"
+	FlagNonrefundableOnly = "nonrefundable-only"
+	FlagChannel           = "channel"
+	FlagDenom             = "denom"
+	FlagTimeout           = "timeout"
+	FlagRetries           = "retries"
+	FlagPayload           = "payload"
+)
+
 // GetQueryCmd returns the query commands for packetforward
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -22,6 +39,13 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdInFlightPacket(),
+		GetCmdInFlightPackets(),
+		GetCmdInFlightPacketsByInboundPacket(),
+		GetCmdRateLimitQuotas(),
+		GetCmdSimulateForward(),
+		GetCmdRecoveredFundsClaims(),
+		GetCmdAudit(),
 	)
 
 	return queryCmd
@@ -55,7 +79,405 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdInFlightPacket returns the command handler for querying a single in-flight packet.
+func GetCmdInFlightPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packet [channel-id] [port-id] [sequence]",
+		Short:   "Query the in-flight packet for a forwarded packet",
+		Long:    "Query the in-flight packet stored for the packet forwarded on the given channel and port with the given sequence",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query packetforward in-flight-packet channel-0 transfer 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			res, err := queryClient.InFlightPacket(cmd.Context(), &types.QueryInFlightPacketRequest{
+				ChannelId: args[0],
+				PortId:    args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInFlightPackets returns the command handler for querying all in-flight packets.
+func GetCmdInFlightPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packets",
+		Short:   "Query all in-flight packets",
+		Long:    "Query all in-flight packets, optionally filtered by refund channel, original sender or nonrefundable flag",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query packetforward in-flight-packets --%s channel-1", version.AppName, FlagRefundChannel),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			refundChannel, err := cmd.Flags().GetString(FlagRefundChannel)
+			if err != nil {
+				return err
+			}
+			originalSender, err := cmd.Flags().GetString(FlagOriginalSender)
+			if err != nil {
+				return err
+			}
+			nonrefundableOnly, err := cmd.Flags().GetBool(FlagNonrefundableOnly)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InFlightPackets(cmd.Context(), &types.QueryInFlightPacketsRequest{
+				RefundChannelId:       refundChannel,
+				OriginalSenderAddress: originalSender,
+				NonrefundableOnly:     nonrefundableOnly,
+				Pagination:            pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRefundChannel, "", "only return packets received on this channel")
+	cmd.Flags().String(FlagOriginalSender, "", "only return packets sent by this address")
+	cmd.Flags().Bool(FlagNonrefundableOnly, false, "only return packets that can no longer be refunded")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight-packets")
+
+	return cmd
+}
+
+// GetCmdInFlightPacketsByInboundPacket returns the command handler for querying the in-flight packets
+// forwarded for an inbound packet.
+func GetCmdInFlightPacketsByInboundPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packets-by-inbound [channel-id] [port-id] [sequence]",
+		Short:   "Query the in-flight packets forwarded for an inbound packet",
+		Long:    "Query the in-flight packets forwarded for the packet received on the given channel and port with the given sequence",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query packetforward in-flight-packets-by-inbound channel-1 transfer 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			res, err := queryClient.InFlightPacketsByInboundPacket(cmd.Context(), &types.QueryInFlightPacketsByInboundPacketRequest{
+				ChannelId: args[0],
+				PortId:    args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRateLimitQuotas returns the command handler for querying the remaining capacity of rate limits.
+func GetCmdRateLimitQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit-quotas",
+		Short:   "Query the remaining capacity of the configured rate limits",
+		Long:    "Query the amount forwarded and remaining in the current window of the configured rate limits, optionally filtered by channel or denom",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query packetforward rate-limit-quotas --%s channel-0", version.AppName, FlagChannel),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			channel, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RateLimitQuotas(cmd.Context(), &types.QueryRateLimitQuotasRequest{
+				ChannelId: channel,
+				Denom:     denom,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagChannel, "", "only return rate limits for this outbound channel")
+	cmd.Flags().String(FlagDenom, "", "only return rate limits for this denom")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSimulateForward returns the command handler for simulating the forward of a packet.
+func GetCmdSimulateForward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-forward [source-port] [source-channel] [destination-port] [destination-channel] [denom] [amount] [sender] [memo]",
+		Short: "Simulate how a packet received by this chain would be forwarded",
+		Long: "Simulate how an ICS-20 packet sent on the given source port and channel and received by this chain on the given " +
+			"destination port and channel would be forwarded according to its memo, without changing any state",
+		Args: cobra.ExactArgs(8),
+		Example: fmt.Sprintf(
+			`%s query packetforward simulate-forward transfer channel-0 transfer channel-1 uatom 100 cosmos1... '{"forward":{"receiver":"osmo1...","port":"transfer","channel":"channel-2"}}'`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateForward(cmd.Context(), &types.QuerySimulateForwardRequest{
+				SourcePort:         args[0],
+				SourceChannel:      args[1],
+				DestinationPort:    args[2],
+				DestinationChannel: args[3],
+				Denom:              args[4],
+				Amount:             args[5],
+				Sender:             args[6],
+				Memo:               args[7],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRecoveredFundsClaims returns the command handler for querying the recovered funds claims of an original sender.
+func GetCmdRecoveredFundsClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recovered-funds-claims [original-sender]",
+		Short:   "Query the pending recovered funds claims of an original sender",
+		Long:    "Query the funds of failed nonrefundable forwards held in the claims escrow account for the given original sender",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query packetforward recovered-funds-claims cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecoveredFundsClaims(cmd.Context(), &types.QueryRecoveredFundsClaimsRequest{
+				OriginalSenderAddress: args[0],
+				Pagination:            pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recovered-funds-claims")
+
+	return cmd
+}
+
+// GetCmdAudit returns the command handler for checking the invariants of the packetforward module.
+func GetCmdAudit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Check the invariants of the packetforward module",
+		Long: "Check that every in-flight packet has a packet commitment on its outbound channel, and that the total escrow " +
+			"tracked by the transfer module is held by its escrow accounts",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query packetforward audit", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Audit(cmd.Context(), &types.QueryAuditRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands for packetforward
 func NewTxCmd() *cobra.Command {
-	return nil
+	txCmd := &cobra.Command{
+		Use:                        "packetforward",
+		Short:                      "Transaction commands for the packetforward module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		GetCmdBuildMemo(),
+		GetCmdClaimRecoveredFunds(),
+	)
+
+	return txCmd
+}
+
+// GetCmdBuildMemo returns the command handler for building the memo of a multi-hop forward.
+func GetCmdBuildMemo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-memo [port/channel/receiver]...",
+		Short: "Build the memo of a transfer forwarded through several chains",
+		Long: "Build the memo of a transfer forwarded through the given hops, each forwarding the packet on the port and channel " +
+			"to the receiver on the next chain. Timeouts and retries are set either once for all hops or once for each hop.",
+		Args: cobra.MinimumNArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx packetforward build-memo transfer/channel-1/pfm transfer/channel-2/osmo1... --%s 10m --%s 2,0 --%s '{"wasm":{...}}'`,
+			version.AppName, FlagTimeout, FlagRetries, FlagPayload,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeouts, err := cmd.Flags().GetStringSlice(FlagTimeout)
+			if err != nil {
+				return err
+			}
+			retries, err := cmd.Flags().GetUintSlice(FlagRetries)
+			if err != nil {
+				return err
+			}
+			payload, err := cmd.Flags().GetString(FlagPayload)
+			if err != nil {
+				return err
+			}
+
+			if len(timeouts) > 1 && len(timeouts) != len(args) {
+				return fmt.Errorf("expected 1 or %d timeouts, got %d", len(args), len(timeouts))
+			}
+			if len(retries) > 1 && len(retries) != len(args) {
+				return fmt.Errorf("expected 1 or %d retries, got %d", len(args), len(retries))
+			}
+
+			builder := types.NewMemoBuilder()
+			for i, arg := range args {
+				parts := strings.SplitN(arg, "/", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid hop %s, expected port/channel/receiver", arg)
+				}
+				hop := types.Hop{Port: parts[0], Channel: parts[1], Receiver: parts[2]}
+
+				if len(timeouts) > 0 {
+					timeout := timeouts[min(i, len(timeouts)-1)]
+					if hop.Timeout, err = time.ParseDuration(timeout); err != nil {
+						return fmt.Errorf("invalid timeout %s: %w", timeout, err)
+					}
+				}
+				if len(retries) > 0 {
+					r := retries[min(i, len(retries)-1)]
+					if r > math.MaxUint8 {
+						return fmt.Errorf("invalid retries %d, must be at most %d", r, math.MaxUint8)
+					}
+					hopRetries := uint8(r)
+					hop.Retries = &hopRetries
+				}
+
+				builder.AddHop(hop)
+			}
+
+			if payload != "" {
+				builder.WithPayload(json.RawMessage(payload))
+			}
+
+			memo, err := builder.Build()
+			if err != nil {
+				return err
+			}
+
+			return client.GetClientContextFromCmd(cmd).PrintString(memo + "\n")
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagTimeout, nil, "timeout of the forward, for all hops or for each hop")
+	cmd.Flags().UintSlice(FlagRetries, nil, "retries of the forward on timeout, for all hops or for each hop")
+	cmd.Flags().String(FlagPayload, "", "JSON object delivered as the memo of the last hop, e.g. a wasm execute message")
+
+	return cmd
+}
+
+// GetCmdClaimRecoveredFunds returns the command handler for claiming the recovered funds of an original sender.
+func GetCmdClaimRecoveredFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-recovered-funds [original-sender]",
+		Short: "Claim the recovered funds of failed nonrefundable forwards",
+		Long: "Claim the funds of failed nonrefundable forwards held in the claims escrow account for the given original sender. " +
+			"The signer must be the account of the original sender on this chain or the delegate approved for it.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx packetforward claim-recovered-funds cosmos1... --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimRecoveredFunds{
+				Claimer:               clientCtx.GetFromAddress().String(),
+				OriginalSenderAddress: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
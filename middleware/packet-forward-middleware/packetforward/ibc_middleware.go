@@ -3,7 +3,6 @@ package packetforward
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
@@ -11,10 +10,8 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -32,13 +29,10 @@ var _ porttypes.Middleware = &IBCMiddleware{}
 type IBCMiddleware struct {
 	app    porttypes.IBCModule
 	keeper *keeper.Keeper
-
-	retriesOnTimeout uint8
-	forwardTimeout   time.Duration
-	refundTimeout    time.Duration
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application. The retries on
+// timeout, forward timeout and refund timeout are used unless the timeouts param replaces them.
 func NewIBCMiddleware(
 	app porttypes.IBCModule,
 	k *keeper.Keeper,
@@ -46,12 +40,15 @@ func NewIBCMiddleware(
 	forwardTimeout time.Duration,
 	refundTimeout time.Duration,
 ) IBCMiddleware {
+	k.SetDefaultTimeouts(types.Timeouts{
+		RetriesOnTimeout: uint32(retriesOnTimeout),
+		ForwardTimeout:   forwardTimeout,
+		RefundTimeout:    refundTimeout,
+	})
+
 	return IBCMiddleware{
-		app:              app,
-		keeper:           k,
-		retriesOnTimeout: retriesOnTimeout,
-		forwardTimeout:   forwardTimeout,
-		refundTimeout:    refundTimeout,
+		app:    app,
+		keeper: k,
 	}
 }
 
@@ -107,24 +104,6 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-func getDenomForThisChain(port, channel, counterpartyPort, counterpartyChannel, denom string) string {
-	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPort, counterpartyChannel)
-	if strings.HasPrefix(denom, counterpartyPrefix) {
-		// unwind denom
-		unwoundDenom := denom[len(counterpartyPrefix):]
-		denomTrace := transfertypes.ParseDenomTrace(unwoundDenom)
-		if denomTrace.Path == "" {
-			// denom is now unwound back to native denom
-			return unwoundDenom
-		}
-		// denom is still IBC denom
-		return denomTrace.IBCDenom()
-	}
-	// append port and channel from this chain to denom
-	prefixedDenom := transfertypes.GetDenomPrefix(port, channel) + denom
-	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
-}
-
 // getBoolFromAny returns the bool value is any is a valid bool, otherwise false.
 func getBoolFromAny(value any) bool {
 	if value == nil {
@@ -138,19 +117,14 @@ func getBoolFromAny(value any) bool {
 }
 
 // GetReceiver returns the receiver address for a given channel and original sender.
-// it overrides the receiver address to be a hash of the channel/origSender so that
-// the receiver address is deterministic and can be used to identify the sender on the
-// initial chain.
+// See types.GetReceiver.
 func GetReceiver(channel string, originalSender string) (string, error) {
-	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
-	senderHash32 := address.Hash(types.ModuleName, []byte(senderStr))
-	sender := sdk.AccAddress(senderHash32[:20])
-	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
+	return types.GetReceiver(channel, originalSender)
 }
 
 // newErrorAcknowledgement returns an error that identifies PFM and provides the error.
-// It's okay if these errors are non-deterministic, because they will not be committed to state, only emitted as events.
+// The acknowledgement is committed, so the error must be deterministic. It is the only record of why a forward failed,
+// since core IBC discards the state and events of an OnRecvPacket that returns an error acknowledgement.
 func newErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 	return channeltypes.Acknowledgement{
 		Response: &channeltypes.Acknowledgement_Error{
@@ -167,6 +141,10 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	if adapter, ok := im.keeper.GetPacketDataAdapter(packet.DestinationPort); ok {
+		return im.onRecvAdapterPacket(ctx, adapter, packet, relayer)
+	}
+
 	logger := im.keeper.Logger(ctx)
 
 	var data transfertypes.FungibleTokenPacketData
@@ -182,6 +160,13 @@ func (im IBCMiddleware) OnRecvPacket(
 		"amount", data.Amount, "denom", data.Denom, "memo", data.Memo,
 	)
 
+	// bound the memo before it is decoded, memos that clearly hold no forward metadata are left to the
+	// underlying application.
+	if err := im.keeper.GetParams(ctx).ValidateMemoSize(data.Memo); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket memo is too large", "error", err)
+		return newErrorAcknowledgement(err)
+	}
+
 	d := make(map[string]interface{})
 	err := json.Unmarshal([]byte(data.Memo), &d)
 	if err != nil || d["forward"] == nil {
@@ -189,6 +174,7 @@ func (im IBCMiddleware) OnRecvPacket(
 		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
+
 	m := &types.PacketMetadata{}
 	err = json.Unmarshal([]byte(data.Memo), m)
 	if err != nil {
@@ -203,11 +189,14 @@ func (im IBCMiddleware) OnRecvPacket(
 	nonrefundable := getBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
 	disableDenomComposition := getBoolFromAny(goCtx.Value(types.DisableDenomCompositionKey{}))
 
-	if err := metadata.Validate(); err != nil {
-		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
+	branches, err := im.keeper.ValidateForward(ctx, packet, data.Denom, data.Amount, metadata)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward is invalid", "error", err)
 		return newErrorAcknowledgement(err)
 	}
 
+	params := im.keeper.GetParams(ctx)
+
 	// override the receiver so that senders cannot move funds through arbitrary addresses.
 	overrideReceiver, err := GetReceiver(packet.DestinationChannel, data.Sender)
 	if err != nil {
@@ -229,43 +218,96 @@ func (im IBCMiddleware) OnRecvPacket(
 	// we do not need to do any further composition of the denom before forwarding the packet
 	denomOnThisChain := data.Denom
 	if !disableDenomComposition {
-		denomOnThisChain = getDenomForThisChain(
+		denomOnThisChain = types.DenomForThisChain(
 			packet.DestinationPort, packet.DestinationChannel,
 			packet.SourcePort, packet.SourceChannel,
 			data.Denom,
 		)
 	}
 
-	amountInt, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		logger.Error("packetForwardMiddleware OnRecvPacket error parsing amount for forward", "amount", data.Amount)
-		return newErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", data.Amount))
+	// each split is forwarded as its own packet, the acknowledgement is written once all of them completed.
+	// Forwards to handlers complete right away.
+	var (
+		handled       int
+		handlerResult []byte
+	)
+	for _, branch := range branches {
+		token := sdk.NewCoin(denomOnThisChain, branch.Amount)
+
+		if branch.Metadata.Handler != "" {
+			result, err := im.keeper.HandleForward(ctx, packet, overrideReceiver, branch.Metadata, token)
+			if err != nil {
+				logger.Error("packetForwardMiddleware OnRecvPacket error handling forward", "error", err)
+				return newErrorAcknowledgement(err)
+			}
+			handled++
+			handlerResult = result
+			continue
+		}
+
+		retries, timeout, backoff, err := im.retryOptions(branch.Metadata, params)
+		if err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket error parsing retry backoff", "error", err)
+			return newErrorAcknowledgement(err)
+		}
+
+		err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, branch.Metadata, token, retries, timeout, backoff, []metrics.Label{}, nonrefundable)
+		if err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
+			return newErrorAcknowledgement(err)
+		}
+	}
+
+	if handled == len(branches) {
+		// all forwards were handled on this chain, so the inbound packet is acknowledged synchronously.
+		if len(handlerResult) == 0 {
+			handlerResult = []byte{byte(1)}
+		}
+		return channeltypes.NewResultAcknowledgement(handlerResult)
+	}
+
+	// the splits forwarded to handlers already succeeded, the others resolve the inbound packet once they complete.
+	if handled > 0 {
+		for i, branch := range branches {
+			if branch.Metadata.Handler != "" {
+				im.keeper.SetHandledSplitForwardResult(ctx, packet, i, branch.Metadata, sdk.NewCoin(denomOnThisChain, branch.Amount))
+			}
+		}
 	}
 
-	token := sdk.NewCoin(denomOnThisChain, amountInt)
+	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
+	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
+	return nil
+}
+
+// retryOptions returns the number of retries, the timeout and the retry backoff of a forward, as set in its
+// metadata or otherwise configured in the params or for the middleware.
+func (im IBCMiddleware) retryOptions(
+	metadata *types.ForwardMetadata,
+	params types.Params,
+) (retries uint8, timeout time.Duration, backoff types.RetryBackoff, err error) {
+	timeouts := im.keeper.GetTimeouts(params)
 
-	timeout := time.Duration(metadata.Timeout)
+	timeout = time.Duration(metadata.Timeout)
 
 	if timeout.Nanoseconds() <= 0 {
-		timeout = im.forwardTimeout
+		timeout = timeouts.ForwardTimeout
 	}
 
-	var retries uint8
 	if metadata.Retries != nil {
 		retries = *metadata.Retries
 	} else {
-		retries = im.retriesOnTimeout
+		retries = uint8(timeouts.RetriesOnTimeout)
 	}
 
-	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, metadata, token, retries, timeout, []metrics.Label{}, nonrefundable)
-	if err != nil {
-		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
-		return newErrorAcknowledgement(err)
+	backoff = params.RetryBackoff
+	if metadata.Backoff != nil {
+		if backoff, err = metadata.Backoff.RetryBackoff(); err != nil {
+			return 0, 0, types.RetryBackoff{}, err
+		}
 	}
 
-	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
-	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
-	return nil
+	return retries, timeout, backoff, nil
 }
 
 // receiveFunds receives funds from the packet into the override receiver
@@ -285,6 +327,17 @@ func (im IBCMiddleware) receiveFunds(
 		// Memo explicitly zeroed
 	}
 	overrideDataBz := transfertypes.ModuleCdc.MustMarshalJSON(&overrideData)
+	return im.receiveOverridePacket(ctx, packet, overrideDataBz, relayer)
+}
+
+// receiveOverridePacket passes the packet with its data overridden to the underlying application and returns
+// an error if it is not received successfully.
+func (im IBCMiddleware) receiveOverridePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	overrideDataBz []byte,
+	relayer sdk.AccAddress,
+) error {
 	overridePacket := channeltypes.Packet{
 		Sequence:           packet.Sequence,
 		SourcePort:         packet.SourcePort,
@@ -316,6 +369,10 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	if adapter, ok := im.keeper.GetPacketDataAdapter(packet.SourcePort); ok {
+		return im.onAcknowledgementAdapterPacket(ctx, adapter, packet, acknowledgement, relayer)
+	}
+
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from ack packet",
@@ -345,11 +402,20 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
 	}
 
+	if im.keeper.AcknowledgeForceRefundedPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence, ack) {
+		// the inbound packet was already refunded by the authority.
+		return nil
+	}
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if adapter, ok := im.keeper.GetPacketDataAdapter(packet.SourcePort); ok {
+		return im.onTimeoutAdapterPacket(ctx, adapter, packet, relayer)
+	}
+
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from timeout packet",
@@ -370,8 +436,9 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 
 	inFlightPacket, err := im.keeper.TimeoutShouldRetry(ctx, packet)
 	if inFlightPacket != nil {
+		// the timed out packet is resolved either way, a retry is stored under its new sequence.
+		im.keeper.RemoveInFlightPacket(ctx, packet)
 		if err != nil {
-			im.keeper.RemoveInFlightPacket(ctx, packet)
 			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
 			// WriteAcknowledgement with proxied ack to return success/fail to previous chain.
 			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, newErrorAcknowledgement(err))
@@ -383,6 +450,11 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, data, inFlightPacket)
 	}
 
+	if im.keeper.ClearForceRefundedPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence) {
+		// the inbound packet was already refunded by the authority, the funds must not be refunded again.
+		return nil
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
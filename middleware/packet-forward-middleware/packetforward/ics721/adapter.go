@@ -0,0 +1,170 @@
+package ics721
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+var _ types.PacketDataAdapter = Adapter{}
+
+// Adapter forwards ICS-721 non-fungible token transfers through the packet forward middleware.
+//
+// Class IDs are traced the same way as ICS-20 denoms: a class received from another chain is identified
+// on this chain by "ibc/" followed by the hash of its full class path.
+type Adapter struct {
+	transferKeeper NFTTransferKeeper
+	nftKeeper      NFTKeeper
+}
+
+// NewAdapter creates a new ICS-721 Adapter given the ICS-721 transfer keeper and the nft keeper.
+func NewAdapter(transferKeeper NFTTransferKeeper, nftKeeper NFTKeeper) Adapter {
+	return Adapter{
+		transferKeeper: transferKeeper,
+		nftKeeper:      nftKeeper,
+	}
+}
+
+// ParsePacketData implements the PacketDataAdapter interface.
+func (a Adapter) ParsePacketData(bz []byte) (types.ForwardablePacketData, error) {
+	var data NonFungibleTokenPacketData
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal ICS-721 transfer packet data: %w", err)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// ReceivePacketData implements the PacketDataAdapter interface.
+func (a Adapter) ReceivePacketData(data types.ForwardablePacketData, receiver string) ([]byte, error) {
+	nftData, err := nonFungibleTokenPacketData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	nftData.Receiver = receiver // override receiver
+	nftData.Memo = ""           // memo explicitly zeroed
+	return json.Marshal(nftData)
+}
+
+// ForwardPacket implements the PacketDataAdapter interface.
+func (a Adapter) ForwardPacket(
+	ctx sdk.Context,
+	inboundPacket channeltypes.Packet,
+	data types.ForwardablePacketData,
+	sender string,
+	metadata *types.ForwardMetadata,
+	memo string,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	nftData, err := nonFungibleTokenPacketData(data)
+	if err != nil {
+		return 0, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	classID := transfertypes.ParseDenomTrace(classPathForThisChain(inboundPacket, nftData.ClassId)).IBCDenom()
+
+	return a.transferKeeper.SendTransfer(
+		ctx,
+		metadata.Port,
+		metadata.Channel,
+		classID,
+		nftData.TokenIds,
+		senderAddr,
+		metadata.Receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		memo,
+	)
+}
+
+// RefundPacket implements the PacketDataAdapter interface.
+func (a Adapter) RefundPacket(
+	ctx sdk.Context,
+	inboundPacket channeltypes.Packet,
+	data types.ForwardablePacketData,
+	port, channel string,
+) error {
+	nftData, err := nonFungibleTokenPacketData(data)
+	if err != nil {
+		return err
+	}
+
+	classPath := classPathForThisChain(inboundPacket, nftData.ClassId)
+	if !transfertypes.SenderChainIsSource(port, channel, classPath) {
+		// the tokens were burned when they were forwarded and are restored by the chain they were forwarded to.
+		return nil
+	}
+
+	// the tokens were moved to the escrow account of the forward, so they need to either:
+	// - move to the escrow account of the inbound channel, in the case of a native class
+	// - burn
+	classID := transfertypes.ParseDenomTrace(classPath).IBCDenom()
+	refundEscrow := transfertypes.SenderChainIsSource(inboundPacket.DestinationPort, inboundPacket.DestinationChannel, classPath)
+	for _, tokenID := range nftData.TokenIds {
+		if refundEscrow {
+			escrowAddress := GetEscrowAddress(inboundPacket.DestinationPort, inboundPacket.DestinationChannel)
+			if err := a.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return fmt.Errorf("failed to transfer nft %s/%s to refund escrow account: %w", classID, tokenID, err)
+			}
+			continue
+		}
+
+		if err := a.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+			return fmt.Errorf("failed to burn nft %s/%s: %w", classID, tokenID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetEscrowAddress returns the escrow address of the ICS-721 transfers on the given port and channel.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// classPathForThisChain returns the full path of the class of an inbound packet on this chain.
+func classPathForThisChain(inboundPacket channeltypes.Packet, classID string) string {
+	counterpartyPrefix := transfertypes.GetDenomPrefix(inboundPacket.SourcePort, inboundPacket.SourceChannel)
+	if strings.HasPrefix(classID, counterpartyPrefix) {
+		// unwind class path
+		return classID[len(counterpartyPrefix):]
+	}
+	return transfertypes.GetDenomPrefix(inboundPacket.DestinationPort, inboundPacket.DestinationChannel) + classID
+}
+
+func nonFungibleTokenPacketData(data types.ForwardablePacketData) (NonFungibleTokenPacketData, error) {
+	nftData, ok := data.(NonFungibleTokenPacketData)
+	if !ok {
+		return NonFungibleTokenPacketData{}, fmt.Errorf("expected ICS-721 transfer packet data, got %T", data)
+	}
+	return nftData, nil
+}
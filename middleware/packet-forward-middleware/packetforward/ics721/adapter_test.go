@@ -0,0 +1,151 @@
+package ics721_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/ics721"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const (
+	senderAddr       = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+	intermediateAddr = "cosmos1v954djef63x2lqj8yy7r3r487heg0exdmkj0sr"
+	destAddr         = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+)
+
+func inboundPacket(classID string) channeltypes.Packet {
+	data, err := json.Marshal(ics721.NonFungibleTokenPacketData{
+		ClassId:  classID,
+		TokenIds: []string{"kitty-1", "kitty-2"},
+		Sender:   senderAddr,
+		Receiver: destAddr,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return channeltypes.Packet{
+		SourcePort:         "nft-transfer",
+		SourceChannel:      "channel-10",
+		DestinationPort:    "nft-transfer",
+		DestinationChannel: "channel-11",
+		Data:               data,
+	}
+}
+
+func TestParsePacketData(t *testing.T) {
+	adapter := ics721.NewAdapter(nil, nil)
+
+	data, err := adapter.ParsePacketData(inboundPacket("kitties").Data)
+	require.NoError(t, err)
+	require.Equal(t, senderAddr, data.GetSender())
+	require.Equal(t, destAddr, data.GetReceiver())
+
+	fungibleData := transfertypes.NewFungibleTokenPacketData("uatom", "100", senderAddr, destAddr, "")
+	_, err = adapter.ParsePacketData(transfertypes.ModuleCdc.MustMarshalJSON(&fungibleData))
+	require.Error(t, err)
+
+	_, err = adapter.ParsePacketData([]byte(`{"classId":"kitties","tokenIds":[],"sender":"a","receiver":"b"}`))
+	require.Error(t, err)
+}
+
+func TestReceivePacketData(t *testing.T) {
+	adapter := ics721.NewAdapter(nil, nil)
+
+	data := ics721.NonFungibleTokenPacketData{
+		ClassId:  "kitties",
+		TokenIds: []string{"kitty-1"},
+		Sender:   senderAddr,
+		Receiver: destAddr,
+		Memo:     `{"forward":{}}`,
+	}
+	bz, err := adapter.ReceivePacketData(data, intermediateAddr)
+	require.NoError(t, err)
+
+	overrideData, err := adapter.ParsePacketData(bz)
+	require.NoError(t, err)
+	require.Equal(t, intermediateAddr, overrideData.GetReceiver())
+	require.Empty(t, overrideData.GetMemo())
+}
+
+func TestForwardPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	ctx := sdk.Context{}
+	transferKeeper := mock.NewMockNFTTransferKeeper(ctl)
+	adapter := ics721.NewAdapter(transferKeeper, nil)
+
+	testCases := []struct {
+		name    string
+		classID string
+		local   string
+	}{
+		{"native class of the sender", "kitties", transfertypes.ParseDenomTrace("nft-transfer/channel-11/kitties").IBCDenom()},
+		{"native class of this chain", "nft-transfer/channel-10/kitties", "kitties"},
+		{"class of another chain", "nft-transfer/channel-10/nft-transfer/channel-5/kitties", transfertypes.ParseDenomTrace("nft-transfer/channel-5/kitties").IBCDenom()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			packet := inboundPacket(tc.classID)
+			data, err := adapter.ParsePacketData(packet.Data)
+			require.NoError(t, err)
+
+			metadata := &types.ForwardMetadata{Receiver: destAddr, Port: "nft-transfer", Channel: "channel-0"}
+			transferKeeper.EXPECT().SendTransfer(
+				ctx, "nft-transfer", "channel-0", tc.local, []string{"kitty-1", "kitty-2"},
+				test.AccAddressFromBech32(t, intermediateAddr), destAddr, clienttypes.ZeroHeight(), uint64(100), "memo",
+			).Return(uint64(1), nil)
+
+			sequence, err := adapter.ForwardPacket(ctx, packet, data, intermediateAddr, metadata, "memo", 100)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), sequence)
+		})
+	}
+}
+
+func TestRefundPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	ctx := sdk.Context{}
+	nftKeeper := mock.NewMockNFTKeeper(ctl)
+	adapter := ics721.NewAdapter(nil, nftKeeper)
+
+	// a native class of this chain returns to the escrow account of the inbound channel.
+	packet := inboundPacket("nft-transfer/channel-10/kitties")
+	data, err := adapter.ParsePacketData(packet.Data)
+	require.NoError(t, err)
+
+	refundEscrow := ics721.GetEscrowAddress("nft-transfer", "channel-11")
+	gomock.InOrder(
+		nftKeeper.EXPECT().Transfer(ctx, "kitties", "kitty-1", refundEscrow).Return(nil),
+		nftKeeper.EXPECT().Transfer(ctx, "kitties", "kitty-2", refundEscrow).Return(nil),
+	)
+	require.NoError(t, adapter.RefundPacket(ctx, packet, data, "nft-transfer", "channel-0"))
+
+	// vouchers received from the inbound channel are burned.
+	packet = inboundPacket("kitties")
+	data, err = adapter.ParsePacketData(packet.Data)
+	require.NoError(t, err)
+
+	voucher := transfertypes.ParseDenomTrace("nft-transfer/channel-11/kitties").IBCDenom()
+	gomock.InOrder(
+		nftKeeper.EXPECT().Burn(ctx, voucher, "kitty-1").Return(nil),
+		nftKeeper.EXPECT().Burn(ctx, voucher, "kitty-2").Return(nil),
+	)
+	require.NoError(t, adapter.RefundPacket(ctx, packet, data, "nft-transfer", "channel-0"))
+
+	// vouchers forwarded back to their source were burned when forwarded.
+	require.NoError(t, adapter.RefundPacket(ctx, packet, data, "nft-transfer", "channel-11"))
+}
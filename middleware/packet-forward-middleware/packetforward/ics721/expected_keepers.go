@@ -0,0 +1,29 @@
+package ics721
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// NFTTransferKeeper defines the expected ICS-721 transfer keeper
+type NFTTransferKeeper interface {
+	SendTransfer(
+		ctx sdk.Context,
+		sourcePort, sourceChannel, classID string,
+		tokenIDs []string,
+		sender sdk.AccAddress,
+		receiver string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		memo string,
+	) (uint64, error)
+}
+
+// NFTKeeper defines the expected nft keeper
+type NFTKeeper interface {
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+}
@@ -0,0 +1,65 @@
+package ics721
+
+import (
+	"errors"
+	"strings"
+)
+
+// Version defines the current version the ICS-721 protocol supports
+const Version = "ics721-1"
+
+// NonFungibleTokenPacketData defines the packet data of an ICS-721 non-fungible token transfer.
+type NonFungibleTokenPacketData struct {
+	ClassId   string   `json:"classId"`
+	ClassUri  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIds  []string `json:"tokenIds"`
+	TokenUris []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// GetSender returns the sender of the transfer.
+func (d NonFungibleTokenPacketData) GetSender() string {
+	return d.Sender
+}
+
+// GetReceiver returns the receiver of the transfer.
+func (d NonFungibleTokenPacketData) GetReceiver() string {
+	return d.Receiver
+}
+
+// GetMemo returns the memo of the transfer.
+func (d NonFungibleTokenPacketData) GetMemo() string {
+	return d.Memo
+}
+
+// ValidateBasic performs a basic check of the packet fields.
+func (d NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(d.ClassId) == "" {
+		return errors.New("class id cannot be blank")
+	}
+	if len(d.TokenIds) == 0 {
+		return errors.New("token ids cannot be empty")
+	}
+	for _, id := range d.TokenIds {
+		if strings.TrimSpace(id) == "" {
+			return errors.New("token id cannot be blank")
+		}
+	}
+	if len(d.TokenUris) != 0 && len(d.TokenUris) != len(d.TokenIds) {
+		return errors.New("the length of token uris must be 0 or the same as the length of token ids")
+	}
+	if len(d.TokenData) != 0 && len(d.TokenData) != len(d.TokenIds) {
+		return errors.New("the length of token data must be 0 or the same as the length of token ids")
+	}
+	if strings.TrimSpace(d.Sender) == "" {
+		return errors.New("sender address cannot be blank")
+	}
+	if strings.TrimSpace(d.Receiver) == "" {
+		return errors.New("receiver address cannot be blank")
+	}
+	return nil
+}
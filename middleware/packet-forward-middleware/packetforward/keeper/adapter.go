@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// SetPacketDataAdapter registers the adapter that forwards the packets of the application bound to the given port.
+func (k *Keeper) SetPacketDataAdapter(portID string, adapter types.PacketDataAdapter) {
+	k.adapters[portID] = adapter
+}
+
+// GetPacketDataAdapter returns the adapter registered for the given port, if any.
+func (k *Keeper) GetPacketDataAdapter(portID string) (types.PacketDataAdapter, bool) {
+	adapter, found := k.adapters[portID]
+	return adapter, found
+}
+
+// ForwardAdapterPacket forwards the assets of an inbound packet handled by a packet data adapter, which were
+// received by the receiver, and stores the in-flight packet for it. Retries pass the in-flight packet of the
+// timed out packet and the inbound packet it was forwarded for.
+func (k *Keeper) ForwardAdapterPacket(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	inFlightPacket *types.InFlightPacket,
+	srcPacket channeltypes.Packet,
+	data types.ForwardablePacketData,
+	receiver string,
+	metadata *types.ForwardMetadata,
+	maxRetries uint8,
+	timeout time.Duration,
+	backoff types.RetryBackoff,
+) error {
+	memo, err := k.nextMemo(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Debug("packetForwardMiddleware ForwardAdapterPacket",
+		"port", metadata.Port, "channel", metadata.Channel,
+		"sender", receiver, "receiver", metadata.Receiver,
+	)
+
+	sequence, err := adapter.ForwardPacket(
+		ctx, srcPacket, data, receiver, metadata, memo,
+		uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
+	)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware ForwardAdapterPacket error",
+			"port", metadata.Port, "channel", metadata.Channel,
+			"sender", receiver, "receiver", metadata.Receiver,
+			"error", err,
+		)
+		return err
+	}
+
+	if inFlightPacket == nil {
+		inFlightPacket = &types.InFlightPacket{
+			PacketData:            srcPacket.Data,
+			OriginalSenderAddress: data.GetSender(),
+			RefundChannelId:       srcPacket.DestinationChannel,
+			RefundPortId:          srcPacket.DestinationPort,
+			RefundSequence:        srcPacket.Sequence,
+			PacketSrcPortId:       srcPacket.SourcePort,
+			PacketSrcChannelId:    srcPacket.SourceChannel,
+
+			PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
+			PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
+
+			RetriesRemaining: int32(maxRetries),
+			Timeout:          uint64(timeout.Nanoseconds()),
+			RetryBackoff:     backoff,
+		}
+	} else {
+		inFlightPacket.RetriesRemaining--
+		inFlightPacket.RetriesAttempted++
+	}
+
+	k.SetInFlightPacket(ctx, metadata.Channel, metadata.Port, sequence, *inFlightPacket)
+	return k.alertReachedInboundTimeout(ctx, metadata.Channel, metadata.Port, sequence, *inFlightPacket)
+}
+
+// RetryAdapterTimeout forwards the assets of a timed out packet handled by a packet data adapter again, once the
+// underlying application returned them to the sender of the timed out packet.
+func (k *Keeper) RetryAdapterTimeout(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	packet channeltypes.Packet,
+	inFlightPacket *types.InFlightPacket,
+) error {
+	forwardedData, err := adapter.ParsePacketData(packet.Data)
+	if err != nil {
+		return fmt.Errorf("error parsing timed out packet data for packetforward retry: %w", err)
+	}
+
+	inboundData, err := adapter.ParsePacketData(inFlightPacket.PacketData)
+	if err != nil {
+		return fmt.Errorf("error parsing inbound packet data for packetforward retry: %w", err)
+	}
+
+	metadata := &types.ForwardMetadata{
+		Receiver: forwardedData.GetReceiver(),
+		Channel:  packet.SourceChannel,
+		Port:     packet.SourcePort,
+	}
+
+	if memo := forwardedData.GetMemo(); memo != "" {
+		metadata.Next = &types.JSONObject{}
+		if err := json.Unmarshal([]byte(memo), metadata.Next); err != nil {
+			return fmt.Errorf("error unmarshaling memo json: %w", err)
+		}
+	}
+
+	timeout := inFlightPacket.RetryBackoff.Timeout(
+		time.Duration(inFlightPacket.Timeout)*time.Nanosecond,
+		inFlightPacket.RetriesAttempted+1,
+	)
+
+	return k.ForwardAdapterPacket(
+		ctx,
+		adapter,
+		inFlightPacket,
+		inboundPacket(inFlightPacket),
+		inboundData,
+		forwardedData.GetSender(),
+		metadata,
+		uint8(inFlightPacket.RetriesRemaining),
+		timeout,
+		inFlightPacket.RetryBackoff,
+	)
+}
+
+// WriteAcknowledgementForForwardedAdapterPacket writes the acknowledgement of a forwarded packet handled by a
+// packet data adapter for its inbound packet, returning the forwarded assets through the adapter first if the
+// forward failed.
+func (k *Keeper) WriteAcknowledgementForForwardedAdapterPacket(
+	ctx sdk.Context,
+	adapter types.PacketDataAdapter,
+	packet channeltypes.Packet,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	if !ack.Success() {
+		data, err := adapter.ParsePacketData(inFlightPacket.PacketData)
+		if err != nil {
+			return fmt.Errorf("error parsing inbound packet data for forward refund: %w", err)
+		}
+
+		if err := adapter.RefundPacket(ctx, inboundPacket(inFlightPacket), data, packet.SourcePort, packet.SourceChannel); err != nil {
+			return err
+		}
+	}
+
+	return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// escrowRecoveredFunds moves the funds of a failed nonrefundable forwarded packet to the claims escrow account
+// and records a claim of them for the original sender, returning the id of the claim.
+func (k *Keeper) escrowRecoveredFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ackErr string,
+) (uint64, error) {
+	token, err := k.moveForwardedFunds(ctx, packet, data, types.ClaimsEscrowAddress())
+	if err != nil {
+		return 0, err
+	}
+
+	claim := types.RecoveredFundsClaim{
+		Id:                    k.nextRecoveredFundsClaimID(ctx),
+		OriginalSenderAddress: inFlightPacket.OriginalSenderAddress,
+		Amount:                token,
+		InboundPortId:         inFlightPacket.RefundPortId,
+		InboundChannelId:      inFlightPacket.RefundChannelId,
+		InboundSequence:       inFlightPacket.RefundSequence,
+		Error:                 ackErr,
+	}
+	k.SetRecoveredFundsClaim(ctx, claim)
+
+	return claim.Id, nil
+}
+
+// nextRecoveredFundsClaimID returns the id for a new recovered funds claim and increments it. Ids start at 1 so
+// that zero never identifies a claim.
+func (k *Keeper) nextRecoveredFundsClaimID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextRecoveredFundsClaimIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextRecoveredFundsClaimIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// SetRecoveredFundsClaim stores a recovered funds claim under its original sender.
+func (k *Keeper) SetRecoveredFundsClaim(ctx sdk.Context, claim types.RecoveredFundsClaim) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecoveredFundsClaimKey(claim.OriginalSenderAddress, claim.Id), k.cdc.MustMarshal(&claim))
+}
+
+// GetRecoveredFundsClaims returns the pending recovered funds claims of the original sender, ordered by id.
+func (k *Keeper) GetRecoveredFundsClaims(ctx sdk.Context, originalSender string) []types.RecoveredFundsClaim {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RecoveredFundsClaimPrefixKey(originalSender))
+	defer iterator.Close()
+
+	var claims []types.RecoveredFundsClaim
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.RecoveredFundsClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// SetClaimDelegate approves the delegate to claim the recovered funds of the original sender, replacing any
+// previously approved delegate. An empty delegate removes the approved delegate.
+func (k *Keeper) SetClaimDelegate(ctx sdk.Context, originalSender, delegate string) {
+	store := ctx.KVStore(k.storeKey)
+	if delegate == "" {
+		store.Delete(types.ClaimDelegateKey(originalSender))
+		return
+	}
+	store.Set(types.ClaimDelegateKey(originalSender), []byte(delegate))
+}
+
+// GetClaimDelegate returns the account approved to claim the recovered funds of the original sender, if any.
+func (k *Keeper) GetClaimDelegate(ctx sdk.Context, originalSender string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClaimDelegateKey(originalSender))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// ClaimRecoveredFunds sends the funds of all pending recovered funds claims of the original sender from the
+// claims escrow account to the claimer and removes the claims. The claimer must be the account of the original
+// sender on this chain, i.e. have the same address bytes, or the delegate approved for it by the authority.
+func (k *Keeper) ClaimRecoveredFunds(ctx sdk.Context, claimer sdk.AccAddress, originalSender string) (sdk.Coins, error) {
+	if !k.isRecoveredFundsClaimer(ctx, claimer, originalSender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s may not claim the recovered funds of %s", claimer, originalSender)
+	}
+
+	claims := k.GetRecoveredFundsClaims(ctx, originalSender)
+	if len(claims) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no recovered funds claims for %s", originalSender)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	amount := sdk.NewCoins()
+	claimIDs := make([]uint64, 0, len(claims))
+	for _, claim := range claims {
+		amount = amount.Add(claim.Amount)
+		claimIDs = append(claimIDs, claim.Id)
+		store.Delete(types.RecoveredFundsClaimKey(originalSender, claim.Id))
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.ClaimsEscrowAddress(), claimer, amount); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to send coins from claims escrow account")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRecoveredFundsClaimed{
+		OriginalSenderAddress: originalSender,
+		Claimer:               claimer.String(),
+		Amount:                amount.String(),
+		ClaimIds:              claimIDs,
+	}); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// isRecoveredFundsClaimer returns true if the claimer may claim the recovered funds of the original sender.
+func (k *Keeper) isRecoveredFundsClaimer(ctx sdk.Context, claimer sdk.AccAddress, originalSender string) bool {
+	if _, sender, err := bech32.DecodeAndConvert(originalSender); err == nil && bytes.Equal(sender, claimer) {
+		return true
+	}
+
+	delegate, found := k.GetClaimDelegate(ctx, originalSender)
+	return found && delegate == claimer.String()
+}
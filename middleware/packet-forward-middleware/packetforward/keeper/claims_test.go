@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestClaimRecoveredFunds(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.ClaimableRecovery = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the original sender is an account on another chain with the same address bytes.
+	owner := test.AccAddress()
+	originalSender, err := bech32.ConvertAndEncode("osmo", owner)
+	require.NoError(t, err)
+
+	packet := inFlightPacket("channel-1", originalSender, true)
+	packet.Denom = "uatom"
+	packet.Amount = "100"
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	// the escrowed funds move to the claims escrow account rather than to the recovery address.
+	chanCap := capabilitytypes.NewCapability(1)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+			Return(transfertypes.ModuleName, chanCap, nil),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress("transfer", "channel-0"),
+			types.ClaimsEscrowAddress(),
+			tokens,
+		).Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").
+			Return(sdk.NewInt64Coin("uatom", 1000)),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 900)),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	require.NoError(t, k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", 5, sdkerrors.ErrInvalidRequest))
+
+	res, err := k.RecoveredFundsClaims(ctx, &types.QueryRecoveredFundsClaimsRequest{OriginalSenderAddress: originalSender})
+	require.NoError(t, err)
+	require.Len(t, res.Claims, 1)
+	require.Equal(t, uint64(1), res.Claims[0].Id)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 100), res.Claims[0].Amount)
+	require.Equal(t, "channel-1", res.Claims[0].InboundChannelId)
+	require.Empty(t, res.Delegate)
+
+	// another account may not claim the funds until the authority approves it as delegate.
+	delegate := test.AccAddress()
+	_, err = msgServer.ClaimRecoveredFunds(ctx, &types.MsgClaimRecoveredFunds{
+		Claimer: delegate.String(), OriginalSenderAddress: originalSender,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetClaimDelegate(ctx, &types.MsgSetClaimDelegate{
+		Authority: test.AccAddress().String(), OriginalSenderAddress: originalSender, Delegate: delegate.String(),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.SetClaimDelegate(ctx, &types.MsgSetClaimDelegate{
+		Authority: k.GetAuthority(), OriginalSenderAddress: originalSender, Delegate: delegate.String(),
+	})
+	require.NoError(t, err)
+
+	res, err = k.RecoveredFundsClaims(ctx, &types.QueryRecoveredFundsClaimsRequest{OriginalSenderAddress: originalSender})
+	require.NoError(t, err)
+	require.Equal(t, delegate.String(), res.Delegate)
+
+	// both the owner and the delegate may claim the funds, but only once.
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, types.ClaimsEscrowAddress(), owner, tokens).Return(nil)
+
+	claimRes, err := msgServer.ClaimRecoveredFunds(ctx, &types.MsgClaimRecoveredFunds{
+		Claimer: owner.String(), OriginalSenderAddress: originalSender,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tokens, claimRes.Amount)
+
+	_, err = msgServer.ClaimRecoveredFunds(ctx, &types.MsgClaimRecoveredFunds{
+		Claimer: delegate.String(), OriginalSenderAddress: originalSender,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	res, err = k.RecoveredFundsClaims(ctx, &types.QueryRecoveredFundsClaimsRequest{OriginalSenderAddress: originalSender})
+	require.NoError(t, err)
+	require.Empty(t, res.Claims)
+}
+
+func TestGetRecoveredFundsClaims(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	// claims of senders sharing a prefix are kept apart.
+	k.SetRecoveredFundsClaim(ctx, types.RecoveredFundsClaim{Id: 2, OriginalSenderAddress: "cosmos1alice", Amount: sdk.NewInt64Coin("uatom", 2)})
+	k.SetRecoveredFundsClaim(ctx, types.RecoveredFundsClaim{Id: 1, OriginalSenderAddress: "cosmos1alice", Amount: sdk.NewInt64Coin("uatom", 1)})
+	k.SetRecoveredFundsClaim(ctx, types.RecoveredFundsClaim{Id: 3, OriginalSenderAddress: "cosmos1alicea", Amount: sdk.NewInt64Coin("uatom", 3)})
+
+	claims := k.GetRecoveredFundsClaims(ctx, "cosmos1alice")
+	require.Len(t, claims, 2)
+	require.Equal(t, uint64(1), claims[0].Id)
+	require.Equal(t, uint64(2), claims[1].Id)
+
+	require.Len(t, k.GetRecoveredFundsClaims(ctx, "cosmos1alicea"), 1)
+	require.Empty(t, k.GetRecoveredFundsClaims(ctx, "cosmos1bob"))
+
+	// claims are not mistaken for in-flight packets.
+	res, err := k.InFlightPackets(ctx, &types.QueryInFlightPacketsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.InFlightPackets)
+}
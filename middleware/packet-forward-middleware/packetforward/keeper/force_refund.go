@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// errForceRefunded is the error the inbound packet of a force refunded in-flight packet is acknowledged with.
+var errForceRefunded = errors.New("in-flight packet force refunded by authority")
+
+// ForceRefundInFlightPacket resolves the in-flight packet forwarded on the given channel, port and sequence
+// as if the forwarded packet failed with the given error: its funds are refunded or recovered and an error
+// acknowledgement is written for the inbound packet. The forwarded packet is marked as force refunded so that
+// a later acknowledgement or timeout of it does not move funds again.
+//
+// A force refund is only safe with proof that the next chain did not receive the forwarded packet. If it was
+// received after all, its funds are delivered as well as refunded, which is only alerted of once it is
+// acknowledged, see AcknowledgeForceRefundedPacket.
+func (k *Keeper) ForceRefundInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64, reason error) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, channel, port, sequence)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "in-flight packet for channel %s, port %s, sequence %d", channel, port, sequence)
+	}
+
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    port,
+		SourceChannel: channel,
+	}
+
+	if adapter, ok := k.GetPacketDataAdapter(port); ok {
+		k.deleteInFlightPacket(ctx, channel, port, sequence)
+		ctx.KVStore(k.storeKey).Set(types.ForceRefundedPacketKey(channel, port, sequence), []byte{1})
+
+		return k.WriteAcknowledgementForForwardedAdapterPacket(ctx, adapter, packet, &inFlightPacket, channeltypes.NewErrorAcknowledgement(reason))
+	}
+
+	if inFlightPacket.Denom == "" {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"in-flight packet for channel %s, port %s, sequence %d does not record the forwarded funds",
+			channel, port, sequence,
+		)
+	}
+
+	// the forwarded packet data carries the full denom path rather than the denom on this chain.
+	fullDenomPath := inFlightPacket.Denom
+	if strings.HasPrefix(fullDenomPath, "ibc/") {
+		var err error
+		fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, fullDenomPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:  fullDenomPath,
+		Amount: inFlightPacket.Amount,
+	}
+
+	k.deleteInFlightPacket(ctx, channel, port, sequence)
+	ctx.KVStore(k.storeKey).Set(types.ForceRefundedPacketKey(channel, port, sequence), []byte{1})
+
+	return k.WriteAcknowledgementForForwardedPacket(ctx, packet, data, &inFlightPacket, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// ClearInFlightPacket removes the in-flight packet forwarded on the given channel, port and sequence without
+// acknowledging its inbound packet or moving any funds. If the forwarded packet was force refunded instead, its
+// force refunded mark is removed, e.g. once the forwarded packet is known to never be acknowledged or timed out.
+func (k *Keeper) ClearInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, channel, port, sequence)
+	if !found {
+		if k.ClearForceRefundedPacket(ctx, channel, port, sequence) {
+			return ctx.EventManager().EmitTypedEvent(&types.EventForwardCleared{
+				OutboundPortId:    port,
+				OutboundChannelId: channel,
+				OutboundSequence:  sequence,
+			})
+		}
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "in-flight packet for channel %s, port %s, sequence %d", channel, port, sequence)
+	}
+
+	k.deleteInFlightPacket(ctx, channel, port, sequence)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardCleared{
+		InboundPortId:     inFlightPacket.RefundPortId,
+		InboundChannelId:  inFlightPacket.RefundChannelId,
+		InboundSequence:   inFlightPacket.RefundSequence,
+		OutboundPortId:    port,
+		OutboundChannelId: channel,
+		OutboundSequence:  sequence,
+	})
+}
+
+// ClearForceRefundedPacket removes the force refunded mark of the forwarded packet on the given channel, port
+// and sequence, returning true if it was marked.
+func (k *Keeper) ClearForceRefundedPacket(ctx sdk.Context, channel, port string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ForceRefundedPacketKey(channel, port, sequence)
+	if !store.Has(key) {
+		return false
+	}
+
+	store.Delete(key)
+	return true
+}
+
+// AcknowledgeForceRefundedPacket removes the force refunded mark of the forwarded packet on the given channel,
+// port and sequence when it is acknowledged, returning true if it was marked. A successful acknowledgement means
+// the funds were delivered on the next chain although they were already refunded, which is logged and alerted
+// of with an EventForwardDeliveredAfterRefund.
+func (k *Keeper) AcknowledgeForceRefundedPacket(
+	ctx sdk.Context,
+	channel, port string,
+	sequence uint64,
+	ack channeltypes.Acknowledgement,
+) bool {
+	if !k.ClearForceRefundedPacket(ctx, channel, port, sequence) {
+		return false
+	}
+
+	if ack.Success() {
+		k.Logger(ctx).Error("packetForwardMiddleware force refunded packet was delivered",
+			"channel", channel, "port", port, "sequence", sequence,
+		)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardDeliveredAfterRefund{
+			OutboundPortId:    port,
+			OutboundChannelId: channel,
+			OutboundSequence:  sequence,
+		}); err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error emitting delivered after refund event", "error", err)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func TestForceRefundInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.Denom = "uatom"
+	packet.Amount = "100"
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	_, err := msgServer.ForceRefundInFlightPacket(ctx, &types.MsgForceRefundInFlightPacket{
+		Authority: test.AccAddress().String(), ChannelId: "channel-0", PortId: "transfer", Sequence: 5,
+	})
+	require.Error(t, err)
+
+	// the escrowed funds of the native denom move to the escrow of the inbound channel.
+	chanCap := capabilitytypes.NewCapability(1)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+			Return(transfertypes.ModuleName, chanCap, nil),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress("transfer", "channel-0"),
+			transfertypes.GetEscrowAddress("transfer", "channel-1"),
+			tokens,
+		).Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").
+			Return(sdk.NewInt64Coin("uatom", 1000)),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 900)),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(
+			ctx,
+			chanCap,
+			channeltypes.Packet{
+				Sequence:           packet.RefundSequence,
+				SourcePort:         packet.PacketSrcPortId,
+				SourceChannel:      packet.PacketSrcChannelId,
+				DestinationPort:    packet.RefundPortId,
+				DestinationChannel: packet.RefundChannelId,
+				TimeoutHeight:      clienttypes.MustParseHeight(packet.PacketTimeoutHeight),
+			},
+			gomock.Any(),
+		).Return(nil),
+	)
+
+	_, err = msgServer.ForceRefundInFlightPacket(ctx, &types.MsgForceRefundInFlightPacket{
+		Authority: k.GetAuthority(), ChannelId: "channel-0", PortId: "transfer", Sequence: 5,
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetInFlightPacket(ctx, "channel-0", "transfer", 5)
+	require.False(t, found)
+
+	// a later acknowledgement or timeout of the forwarded packet is ignored once.
+	require.True(t, k.ClearForceRefundedPacket(ctx, "channel-0", "transfer", 5))
+	require.False(t, k.ClearForceRefundedPacket(ctx, "channel-0", "transfer", 5))
+
+	_, err = msgServer.ForceRefundInFlightPacket(ctx, &types.MsgForceRefundInFlightPacket{
+		Authority: k.GetAuthority(), ChannelId: "channel-0", PortId: "transfer", Sequence: 5,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+// forceRefundNativeInFlightPacket force refunds an in-flight packet of a native denom forwarded on channel-0.
+func forceRefundNativeInFlightPacket(t *testing.T, setup *test.Setup, ctx sdk.Context, sequence uint64) {
+	t.Helper()
+	k := setup.Keepers.PacketForwardKeeper
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.Denom = "uatom"
+	packet.Amount = "100"
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", sequence, packet)
+
+	chanCap := capabilitytypes.NewCapability(1)
+	setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+		Return(transfertypes.ModuleName, chanCap, nil)
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 1000))
+	setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 900))
+	setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).Return(nil)
+
+	require.NoError(t, k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", sequence, sdkerrors.ErrInvalidRequest))
+}
+
+func TestAcknowledgeForceRefundedPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	k := setup.Keepers.PacketForwardKeeper
+
+	delivered := func(ctx sdk.Context) []uint64 {
+		t.Helper()
+		var sequences []uint64
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			if alert, ok := msg.(*types.EventForwardDeliveredAfterRefund); ok {
+				sequences = append(sequences, alert.OutboundSequence)
+			}
+		}
+		return sequences
+	}
+
+	// an error acknowledgement of a force refunded packet is expected and ignored.
+	ctx := setup.Initializer.Ctx.WithEventManager(sdk.NewEventManager())
+	forceRefundNativeInFlightPacket(t, setup, ctx, 5)
+	require.True(t, k.AcknowledgeForceRefundedPacket(ctx, "channel-0", "transfer", 5, channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInvalidRequest)))
+	require.Empty(t, delivered(ctx))
+
+	// a successful one means the funds were paid out twice, which is alerted of.
+	ctx = setup.Initializer.Ctx.WithEventManager(sdk.NewEventManager())
+	forceRefundNativeInFlightPacket(t, setup, ctx, 6)
+	require.True(t, k.AcknowledgeForceRefundedPacket(ctx, "channel-0", "transfer", 6, channeltypes.NewResultAcknowledgement([]byte{1})))
+	require.Equal(t, []uint64{6}, delivered(ctx))
+
+	require.False(t, k.AcknowledgeForceRefundedPacket(ctx, "channel-0", "transfer", 6, channeltypes.NewResultAcknowledgement([]byte{1})))
+}
+
+func TestClearForceRefundedPacketMark(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	forceRefundNativeInFlightPacket(t, setup, ctx, 5)
+
+	// the authority removes the mark of a forwarded packet that will never be acknowledged or timed out.
+	require.NoError(t, k.ClearInFlightPacket(ctx, "channel-0", "transfer", 5))
+	require.False(t, k.ClearForceRefundedPacket(ctx, "channel-0", "transfer", 5))
+	require.ErrorIs(t, k.ClearInFlightPacket(ctx, "channel-0", "transfer", 5), sdkerrors.ErrNotFound)
+}
+
+func TestForceRefundInFlightPacketWithoutFunds(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	// in-flight packets stored before the forwarded funds were recorded can only be cleared.
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, inFlightPacket("channel-1", "cosmos1alice", false))
+
+	err := k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", 5, sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, found := k.GetInFlightPacket(ctx, "channel-0", "transfer", 5)
+	require.True(t, found)
+}
+
+func TestClearInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	req := &types.MsgClearInFlightPacket{Authority: k.GetAuthority(), ChannelId: "channel-0", PortId: "transfer", Sequence: 5}
+	_, err := msgServer.ClearInFlightPacket(ctx, req)
+	require.NoError(t, err)
+
+	_, found := k.GetInFlightPacket(ctx, "channel-0", "transfer", 5)
+	require.False(t, found)
+	require.Empty(t, k.GetInFlightPacketsByInboundPacket(ctx, packet.RefundChannelId, packet.RefundPortId, packet.RefundSequence))
+
+	// clearing does not mark the forwarded packet as refunded.
+	require.False(t, k.ClearForceRefundedPacket(ctx, "channel-0", "transfer", 5))
+
+	_, err = msgServer.ClearInFlightPacket(ctx, req)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+// hexRecoveryAddressResolver resolves hex encoded recover addresses, as used by chains with EVM accounts.
+type hexRecoveryAddressResolver struct{}
+
+func (hexRecoveryAddressResolver) ResolveRecoveryAddress(_ sdk.Context, inFlightPacket *types.InFlightPacket) (sdk.AccAddress, error) {
+	return hex.DecodeString(strings.TrimPrefix(inFlightPacket.RecoverAddress, "0x"))
+}
+
+func TestForceRefundNonrefundableInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+	k.SetRecoveryAddressResolver(hexRecoveryAddressResolver{})
+
+	recoverAddress := test.AccAddress()
+	packet := inFlightPacket("channel-1", "cosmos1alice", true)
+	packet.Denom = "uatom"
+	packet.Amount = "100"
+	packet.RecoverAddress = "0x" + hex.EncodeToString(recoverAddress)
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	// the escrowed funds move to the account resolved from the recover address.
+	chanCap := capabilitytypes.NewCapability(1)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+			Return(transfertypes.ModuleName, chanCap, nil),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress("transfer", "channel-0"),
+			recoverAddress,
+			tokens,
+		).Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").
+			Return(sdk.NewInt64Coin("uatom", 1000)),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 900)),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	require.NoError(t, k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", 5, sdkerrors.ErrInvalidRequest))
+}
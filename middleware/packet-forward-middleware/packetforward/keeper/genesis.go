@@ -1,8 +1,16 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,12 +21,48 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	// Initialize store refund path for forwarded packets in genesis state that have not yet been acked.
+	for _, entry := range state.InFlightPackets {
+		k.SetInFlightPacket(ctx, entry.ChannelId, entry.PortId, entry.Sequence, entry.InFlightPacket)
+	}
+
+	for _, claim := range state.RecoveredFundsClaims {
+		k.SetRecoveredFundsClaim(ctx, claim)
+	}
+	if state.NextRecoveredFundsClaimId > 0 {
+		ctx.KVStore(k.storeKey).Set(types.NextRecoveredFundsClaimIDKey, sdk.Uint64ToBigEndian(state.NextRecoveredFundsClaimId))
+	}
+
+	for _, delegate := range state.ClaimDelegates {
+		k.SetClaimDelegate(ctx, delegate.OriginalSenderAddress, delegate.Delegate)
+	}
+
+	// flows are set after params, as setting params prunes the flows of rate limits that are not configured.
+	for _, entry := range state.RateLimitFlows {
+		k.setRateLimitFlow(ctx, entry.ChannelId, entry.Denom, entry.Flow)
+	}
+
 	store := ctx.KVStore(k.storeKey)
-	for key, value := range state.InFlightPackets {
-		key := key
-		value := value
-		bz := k.cdc.MustMarshal(&value)
-		store.Set([]byte(key), bz)
+	for _, packet := range state.ForceRefundedPackets {
+		store.Set(types.ForceRefundedPacketKey(packet.ChannelId, packet.PortId, packet.Sequence), []byte{1})
+	}
+
+	for _, entry := range state.SplitForwardResults {
+		key := types.SplitForwardResultKey(
+			entry.InboundChannelId, entry.InboundPortId, entry.InboundSequence,
+			entry.Result.ChannelId, entry.Result.PortId, entry.Result.Sequence,
+		)
+		store.Set(key, k.cdc.MustMarshal(&entry.Result))
+	}
+
+	if state.TimeoutWatchdogTimestamp > 0 {
+		if err := k.timeoutWatchdogTimestamp.Set(ctx, state.TimeoutWatchdogTimestamp); err != nil {
+			panic(err)
+		}
+	}
+	if state.TimeoutWatchdogHeight > 0 {
+		if err := k.timeoutWatchdogHeight.Set(ctx, state.TimeoutWatchdogHeight); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -26,13 +70,99 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	store := ctx.KVStore(k.storeKey)
 
-	inFlightPackets := make(map[string]types.InFlightPacket)
+	inFlightPackets := []types.InFlightPacketEntry{}
+	k.IterateInFlightPackets(ctx, func(entry types.InFlightPacketEntry) bool {
+		inFlightPackets = append(inFlightPackets, entry)
+		return false
+	})
+
+	var claims []types.RecoveredFundsClaim
+	itr := storetypes.KVStorePrefixIterator(store, types.RecoveredFundsClaimPrefix)
+	for ; itr.Valid(); itr.Next() {
+		var claim types.RecoveredFundsClaim
+		k.cdc.MustUnmarshal(itr.Value(), &claim)
+		claims = append(claims, claim)
+	}
+	itr.Close()
+
+	var nextClaimID uint64
+	if bz := store.Get(types.NextRecoveredFundsClaimIDKey); bz != nil {
+		nextClaimID = sdk.BigEndianToUint64(bz)
+	}
+
+	var delegates []types.ClaimDelegate
+	itr = prefix.NewStore(store, types.ClaimDelegatePrefix).Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		delegates = append(delegates, types.ClaimDelegate{
+			OriginalSenderAddress: string(itr.Key()),
+			Delegate:              string(itr.Value()),
+		})
+	}
+	itr.Close()
+
+	var flows []types.RateLimitFlowEntry
+	itr = prefix.NewStore(store, types.RateLimitFlowPrefix).Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		// channel ids cannot contain a slash, while denoms may.
+		channel, denom, found := strings.Cut(string(itr.Key()), "/")
+		if !found {
+			panic(fmt.Errorf("invalid rate limit flow key %q", itr.Key()))
+		}
+		entry := types.RateLimitFlowEntry{ChannelId: channel, Denom: denom}
+		k.cdc.MustUnmarshal(itr.Value(), &entry.Flow)
+		flows = append(flows, entry)
+	}
+	itr.Close()
 
-	itr := store.Iterator(nil, nil)
+	var forceRefunded []types.ForceRefundedPacket
+	itr = prefix.NewStore(store, types.ForceRefundedPacketPrefix).Iterator(nil, nil)
 	for ; itr.Valid(); itr.Next() {
-		var inFlightPacket types.InFlightPacket
-		k.cdc.MustUnmarshal(itr.Value(), &inFlightPacket)
-		inFlightPackets[string(itr.Key())] = inFlightPacket
+		channel, port, sequence, err := types.ParseRefundPacketKey(itr.Key())
+		if err != nil {
+			panic(err)
+		}
+		forceRefunded = append(forceRefunded, types.ForceRefundedPacket{ChannelId: channel, PortId: port, Sequence: sequence})
+	}
+	itr.Close()
+
+	var splitResults []types.SplitForwardResultEntry
+	itr = prefix.NewStore(store, types.SplitForwardResultPrefix).Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		// the key holds the inbound packet followed by the forwarded packet, which the result holds as well.
+		parts := strings.SplitN(string(itr.Key()), "/", 4)
+		if len(parts) != 4 {
+			panic(fmt.Errorf("invalid split forward result key %q", itr.Key()))
+		}
+		channel, port, sequence, err := types.ParseRefundPacketKey([]byte(strings.Join(parts[:3], "/")))
+		if err != nil {
+			panic(err)
+		}
+		entry := types.SplitForwardResultEntry{InboundChannelId: channel, InboundPortId: port, InboundSequence: sequence}
+		k.cdc.MustUnmarshal(itr.Value(), &entry.Result)
+		splitResults = append(splitResults, entry)
+	}
+	itr.Close()
+
+	watchdogTimestamp, err := k.timeoutWatchdogTimestamp.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	watchdogHeight, err := k.timeoutWatchdogHeight.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:                   k.GetParams(ctx),
+		InFlightPackets:          inFlightPackets,
+		RecoveredFundsClaims:     claims,
+		ClaimDelegates:           delegates,
+		RateLimitFlows:           flows,
+		ForceRefundedPackets:     forceRefunded,
+		SplitForwardResults:      splitResults,
+		TimeoutWatchdogTimestamp: watchdogTimestamp,
+		TimeoutWatchdogHeight:    watchdogHeight,
+
+		NextRecoveredFundsClaimId: nextClaimID,
 	}
-	return &types.GenesisState{Params: k.GetParams(ctx), InFlightPackets: inFlightPackets}
 }
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisImportExport(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	delegate := test.AccAddress().String()
+	state := types.GenesisState{
+		Params: types.DefaultParams(),
+		InFlightPackets: []types.InFlightPacketEntry{
+			{ChannelId: "channel-0", PortId: "transfer", Sequence: 1, InFlightPacket: inFlightPacket("channel-1", "cosmos1alice", false)},
+			{ChannelId: "channel-2", PortId: "transfer", Sequence: 4, InFlightPacket: inFlightPacket("channel-3", "cosmos1bob", true)},
+		},
+		RecoveredFundsClaims: []types.RecoveredFundsClaim{
+			{Id: 3, OriginalSenderAddress: "cosmos1alice", Amount: sdk.NewInt64Coin("uatom", 100), InboundPortId: "transfer", InboundChannelId: "channel-1", InboundSequence: 1},
+		},
+		ClaimDelegates: []types.ClaimDelegate{
+			{OriginalSenderAddress: "cosmos1alice", Delegate: delegate},
+		},
+		RateLimitFlows: []types.RateLimitFlowEntry{
+			{ChannelId: "channel-0", Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Flow: types.RateLimitFlow{
+				WindowStart: time.Unix(1_700_000_000, 0).UTC(),
+				Amount:      sdkmath.NewInt(250),
+			}},
+		},
+		ForceRefundedPackets: []types.ForceRefundedPacket{
+			{ChannelId: "channel-0", PortId: "transfer", Sequence: 2},
+		},
+		SplitForwardResults: []types.SplitForwardResultEntry{
+			{InboundChannelId: "channel-1", InboundPortId: "transfer", InboundSequence: 5, Result: types.SplitForwardResult{
+				ChannelId: "channel-0", PortId: "transfer", Sequence: 3, Denom: "transfer/channel-1/uatom", Amount: "40",
+				Error: "timeout",
+			}},
+		},
+		TimeoutWatchdogTimestamp: 1_700_000_600_000_000_000,
+		TimeoutWatchdogHeight:    105,
+
+		// claims 3 to 8 were created, claims 4 to 8 were already claimed.
+		NextRecoveredFundsClaimId: 9,
+	}
+	state.Params.RateLimits = []types.RateLimit{
+		{ChannelId: "channel-0", Denom: state.RateLimitFlows[0].Denom, MaxAmount: sdkmath.NewInt(1000), Period: time.Hour},
+	}
+	require.NoError(t, state.Validate())
+
+	k.InitGenesis(ctx, state)
+
+	// params, indexes and claims are not exported as in-flight packets, and the rate limit flows are kept by
+	// the params they were imported with.
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, state, *exported)
+
+	quota := k.GetRateLimitQuota(ctx.WithBlockTime(time.Unix(1_700_000_000, 0)), state.Params.RateLimits[0])
+	require.Equal(t, sdkmath.NewInt(250), quota.Used)
+
+	entries := k.GetInFlightPacketsByInboundPacket(ctx, "channel-3", "transfer", 1)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(4), entries[0].Sequence)
+}
@@ -2,10 +2,22 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -18,3 +30,288 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InFlightPacket returns the in-flight packet stored for a forwarded packet.
+func (k Keeper) InFlightPacket(c context.Context, req *types.QueryInFlightPacketRequest) (*types.QueryInFlightPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inFlightPacket, found := k.GetInFlightPacket(ctx, req.ChannelId, req.PortId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"in-flight packet not found for channel %s, port %s, sequence %d",
+			req.ChannelId, req.PortId, req.Sequence,
+		)
+	}
+
+	return &types.QueryInFlightPacketResponse{InFlightPacket: inFlightPacket}, nil
+}
+
+// InFlightPackets returns all in-flight packets matching the request filters.
+func (k Keeper) InFlightPackets(c context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries, pageRes, err := query.CollectionFilteredPaginate(
+		ctx, k.inFlightPackets, req.Pagination,
+		func(_ types.PacketKey, inFlightPacket types.InFlightPacket) (bool, error) {
+			if req.RefundChannelId != "" && inFlightPacket.RefundChannelId != req.RefundChannelId {
+				return false, nil
+			}
+			if req.OriginalSenderAddress != "" && inFlightPacket.OriginalSenderAddress != req.OriginalSenderAddress {
+				return false, nil
+			}
+			if req.NonrefundableOnly && !inFlightPacket.Nonrefundable {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(key types.PacketKey, inFlightPacket types.InFlightPacket) (types.InFlightPacketEntry, error) {
+			return inFlightPacketEntry(key, inFlightPacket), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{
+		InFlightPackets: entries,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// InFlightPacketsByInboundPacket returns the in-flight packets that were forwarded for an inbound packet.
+func (k Keeper) InFlightPacketsByInboundPacket(
+	c context.Context,
+	req *types.QueryInFlightPacketsByInboundPacketRequest,
+) (*types.QueryInFlightPacketsByInboundPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries := k.GetInFlightPacketsByInboundPacket(ctx, req.ChannelId, req.PortId, req.Sequence)
+	if len(entries) == 0 {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"no in-flight packets found for inbound packet on channel %s, port %s, sequence %d",
+			req.ChannelId, req.PortId, req.Sequence,
+		)
+	}
+
+	return &types.QueryInFlightPacketsByInboundPacketResponse{InFlightPackets: entries}, nil
+}
+
+// RateLimitQuotas returns the usage of the configured rate limits in their current windows.
+func (k Keeper) RateLimitQuotas(c context.Context, req *types.QueryRateLimitQuotasRequest) (*types.QueryRateLimitQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var quotas []types.RateLimitQuota
+	for _, limit := range k.GetParams(ctx).RateLimits {
+		if req.ChannelId != "" && limit.ChannelId != req.ChannelId {
+			continue
+		}
+		if req.Denom != "" && limit.Denom != req.Denom {
+			continue
+		}
+
+		quotas = append(quotas, k.GetRateLimitQuota(ctx, limit))
+	}
+
+	return &types.QueryRateLimitQuotasResponse{Quotas: quotas}, nil
+}
+
+// SimulateForward resolves how an ICS-20 packet received by this chain would be forwarded according to its
+// memo, running the same parsing and validation as OnRecvPacket without changing any state. A packet that
+// would be acknowledged with an error is reported in the response rather than as a query error.
+func (k Keeper) SimulateForward(c context.Context, req *types.QuerySimulateForwardRequest) (*types.QuerySimulateForwardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	for _, channelID := range []string{req.SourceChannel, req.DestinationChannel} {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, portID := range []string{req.SourcePort, req.DestinationPort} {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QuerySimulateForwardResponse{
+		Denom: types.DenomForThisChain(req.DestinationPort, req.DestinationChannel, req.SourcePort, req.SourceChannel, req.Denom),
+	}
+
+	if err := k.simulateForward(ctx, req, res); err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// simulateForward fills the response with the forwards of the simulated packet, returning the error the packet
+// would be acknowledged with if it cannot be forwarded.
+func (k Keeper) simulateForward(ctx sdk.Context, req *types.QuerySimulateForwardRequest, res *types.QuerySimulateForwardResponse) error {
+	params := k.GetParams(ctx)
+	if err := params.ValidateMemoSize(req.Memo); err != nil {
+		return err
+	}
+
+	d := make(map[string]interface{})
+	err := json.Unmarshal([]byte(req.Memo), &d)
+	if err != nil || d["forward"] == nil {
+		// not a packet that should be forwarded
+		return nil
+	}
+
+	m := &types.PacketMetadata{}
+	if err := json.Unmarshal([]byte(req.Memo), m); err != nil {
+		return fmt.Errorf("error parsing forward metadata: %w", err)
+	}
+
+	packet := channeltypes.Packet{
+		SourcePort:         req.SourcePort,
+		SourceChannel:      req.SourceChannel,
+		DestinationPort:    req.DestinationPort,
+		DestinationChannel: req.DestinationChannel,
+	}
+	branches, err := k.ValidateForward(ctx, packet, req.Denom, req.Amount, m.Forward)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := types.GetReceiver(req.DestinationChannel, req.Sender)
+	if err != nil {
+		return fmt.Errorf("failed to construct override receiver: %w", err)
+	}
+	res.IntermediateReceiver = receiver
+
+	// the rate limits are checked against a discarded cache of the store, so the usage of splits forwarded on the
+	// same channel adds up without the query changing any state.
+	rateLimitCtx, _ := ctx.CacheContext()
+	forwards := make([]types.SimulatedForward, 0, len(branches))
+	for _, branch := range branches {
+		if branch.Metadata.Backoff != nil {
+			if _, err := branch.Metadata.Backoff.RetryBackoff(); err != nil {
+				return err
+			}
+		}
+
+		memo, err := k.nextMemo(ctx, branch.Metadata)
+		if err != nil {
+			return err
+		}
+
+		// forwards to handlers are not charged the forward fee.
+		fee := sdkmath.ZeroInt()
+		if branch.Metadata.Handler == "" {
+			fee = params.ForwardFee(branch.Metadata.Channel, sdk.NewCoin(res.Denom, branch.Amount))
+			if err := types.ValidateForwardFee(fee, sdk.NewCoin(res.Denom, branch.Amount)); err != nil {
+				return err
+			}
+			if _, err := k.CheckAndUpdateRateLimit(rateLimitCtx, branch.Metadata.Channel, sdk.NewCoin(res.Denom, branch.Amount.Sub(fee))); err != nil {
+				return err
+			}
+		}
+		relayerIncentive := sdkmath.ZeroInt()
+		if _, incentiveFee, found := k.relayerIncentive(ctx, branch.Metadata, sdk.NewCoin(res.Denom, fee)); found {
+			relayerIncentive = incentiveFee.Total().AmountOf(res.Denom)
+		}
+		forwards = append(forwards, types.SimulatedForward{
+			PortId:           branch.Metadata.Port,
+			ChannelId:        branch.Metadata.Channel,
+			Receiver:         branch.Metadata.Receiver,
+			Amount:           branch.Amount.Sub(fee),
+			Fee:              fee,
+			Memo:             memo,
+			Handler:          branch.Metadata.Handler,
+			RelayerIncentive: relayerIncentive,
+		})
+	}
+
+	res.Forwards = forwards
+	return nil
+}
+
+// RecoveredFundsClaims returns the pending recovered funds claims of an original sender.
+func (k Keeper) RecoveredFundsClaims(
+	c context.Context,
+	req *types.QueryRecoveredFundsClaimsRequest,
+) (*types.QueryRecoveredFundsClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.OriginalSenderAddress) == "" {
+		return nil, status.Error(codes.InvalidArgument, "original sender address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecoveredFundsClaimPrefixKey(req.OriginalSenderAddress))
+
+	var claims []types.RecoveredFundsClaim
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var claim types.RecoveredFundsClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegate, _ := k.GetClaimDelegate(ctx, req.OriginalSenderAddress)
+
+	return &types.QueryRecoveredFundsClaimsResponse{
+		Claims:     claims,
+		Delegate:   delegate,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Audit checks the invariants of the module without halting the chain.
+func (k Keeper) Audit(c context.Context, req *types.QueryAuditRequest) (*types.QueryAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	uncommitted := k.UncommittedInFlightPackets(ctx)
+	totalEscrowed, escrowBalances := k.EscrowTotals(ctx)
+
+	return &types.QueryAuditResponse{
+		Broken:                     len(uncommitted) > 0 || !escrowBalances.IsAllGTE(totalEscrowed),
+		UncommittedInFlightPackets: uncommitted,
+		TotalEscrowed:              totalEscrowed,
+		EscrowBalances:             escrowBalances,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func inFlightPacket(refundChannel, sender string, nonrefundable bool) types.InFlightPacket {
+	return types.InFlightPacket{
+		OriginalSenderAddress: sender,
+		RefundChannelId:       refundChannel,
+		RefundPortId:          "transfer",
+		PacketSrcChannelId:    "channel-99",
+		PacketSrcPortId:       "transfer",
+		PacketTimeoutHeight:   "0-0",
+		RefundSequence:        1,
+		Nonrefundable:         nonrefundable,
+	}
+}
+
+func TestQueryInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	packet := inFlightPacket("channel-1", "cosmos1sender", false)
+	k.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		{ChannelId: "channel-0", PortId: "transfer", Sequence: 5, InFlightPacket: packet},
+	}))
+
+	res, err := k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: "channel-0", PortId: "transfer", Sequence: 5})
+	require.NoError(t, err)
+	require.Equal(t, packet, res.InFlightPacket)
+
+	_, err = k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: "channel-0", PortId: "transfer", Sequence: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: "", PortId: "transfer", Sequence: 5})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryInFlightPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	k.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		{ChannelId: "channel-0", PortId: "transfer", Sequence: 1, InFlightPacket: inFlightPacket("channel-1", "cosmos1alice", false)},
+		{ChannelId: "channel-0", PortId: "transfer", Sequence: 2, InFlightPacket: inFlightPacket("channel-1", "cosmos1bob", true)},
+		{ChannelId: "channel-2", PortId: "transfer", Sequence: 1, InFlightPacket: inFlightPacket("channel-3", "cosmos1alice", true)},
+	}))
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryInFlightPacketsRequest
+		expected []string
+	}{
+		{
+			"no filter",
+			&types.QueryInFlightPacketsRequest{},
+			[]string{"channel-0/transfer/1", "channel-0/transfer/2", "channel-2/transfer/1"},
+		},
+		{
+			"refund channel",
+			&types.QueryInFlightPacketsRequest{RefundChannelId: "channel-3"},
+			[]string{"channel-2/transfer/1"},
+		},
+		{
+			"original sender",
+			&types.QueryInFlightPacketsRequest{OriginalSenderAddress: "cosmos1alice"},
+			[]string{"channel-0/transfer/1", "channel-2/transfer/1"},
+		},
+		{
+			"nonrefundable only",
+			&types.QueryInFlightPacketsRequest{RefundChannelId: "channel-1", NonrefundableOnly: true},
+			[]string{"channel-0/transfer/2"},
+		},
+		{
+			"paginated",
+			&types.QueryInFlightPacketsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			[]string{"channel-0/transfer/2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.InFlightPackets(ctx, tc.req)
+			require.NoError(t, err)
+
+			keys := make([]string, len(res.InFlightPackets))
+			for i, entry := range res.InFlightPackets {
+				keys[i] = string(types.RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence))
+			}
+			require.Equal(t, tc.expected, keys)
+		})
+	}
+}
+
+func TestQueryInFlightPacketsByInboundPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.RefundSequence = 7
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 3, packet)
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 4, inFlightPacket("channel-1", "cosmos1bob", false))
+
+	req := &types.QueryInFlightPacketsByInboundPacketRequest{ChannelId: "channel-1", PortId: "transfer", Sequence: 7}
+	res, err := k.InFlightPacketsByInboundPacket(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []types.InFlightPacketEntry{{
+		ChannelId:      "channel-0",
+		PortId:         "transfer",
+		Sequence:       3,
+		InFlightPacket: packet,
+	}}, res.InFlightPackets)
+
+	// the index is not exported as an in-flight packet.
+	require.Len(t, k.ExportGenesis(ctx).InFlightPackets, 2)
+
+	require.NotNil(t, k.GetAndClearInFlightPacket(ctx, "channel-0", "transfer", 3))
+	_, err = k.InFlightPacketsByInboundPacket(ctx, req)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQuerySimulateForward(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	params := types.DefaultParams()
+	params.FeePercentage = sdkmath.LegacyNewDecWithPrec(10, 2)
+	params.DeniedRoutes = []types.ForwardRoute{{InboundChannelId: "channel-1", OutboundChannelId: "channel-3"}}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	sender := "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+	receiver, err := types.GetReceiver("channel-1", sender)
+	require.NoError(t, err)
+
+	request := func(memo string) *types.QuerySimulateForwardRequest {
+		return &types.QuerySimulateForwardRequest{
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+			Denom:              "uatom",
+			Amount:             "100",
+			Sender:             sender,
+			Memo:               memo,
+		}
+	}
+	denom := types.DenomForThisChain("transfer", "channel-1", "transfer", "channel-0", "uatom")
+
+	res, err := k.SimulateForward(ctx, request(`{"forward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-2","next":{"wasm":{}}}}`))
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySimulateForwardResponse{
+		Denom:                denom,
+		IntermediateReceiver: receiver,
+		Forwards: []types.SimulatedForward{{
+			PortId:    "transfer",
+			ChannelId: "channel-2",
+			Receiver:  "cosmos1dest",
+			Amount:    sdkmath.NewInt(90),
+			Fee:       sdkmath.NewInt(10),
+			Memo:      `{"wasm":{}}`,
+
+			RelayerIncentive: sdkmath.ZeroInt(),
+		}},
+	}, res)
+
+	// packets without forward metadata are received as usual.
+	res, err = k.SimulateForward(ctx, request(""))
+	require.NoError(t, err)
+	require.Empty(t, res.Forwards)
+	require.Empty(t, res.Error)
+
+	// packets that would be acknowledged with an error report it.
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"receiver":"cosmos1dest","port":"transfer"}}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Error)
+
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-3"}}`))
+	require.NoError(t, err)
+	require.Contains(t, res.Error, types.ErrForwardRouteNotAllowed.Error())
+
+	// forwards are checked against the rate limits without using up their quota.
+	limit := types.RateLimit{ChannelId: "channel-2", Denom: denom, MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	params.RateLimits = []types.RateLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-2", sdk.NewCoin(denom, sdkmath.NewInt(10)))))
+
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-2"}}`))
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-2", sdk.NewCoin(denom, sdkmath.NewInt(20)))))
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-2"}}`))
+	require.NoError(t, err)
+	require.Contains(t, res.Error, types.ErrRateLimitExceeded.Error())
+	require.Equal(t, sdkmath.NewInt(30), k.GetRateLimitQuota(ctx, limit).Used)
+	params.RateLimits = nil
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the memo size limit applies to forward metadata under an escaped key as well.
+	params.MaxMemoSize = 128
+	require.NoError(t, k.SetParams(ctx, params))
+	res, err = k.SimulateForward(ctx, request(fmt.Sprintf(
+		`{"\u0066orward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-2"},"pad":"%s"}`, strings.Repeat("a", 128),
+	)))
+	require.NoError(t, err)
+	require.Contains(t, res.Error, types.ErrMemoTooLarge.Error())
+	params.MaxMemoSize = types.DefaultParams().MaxMemoSize
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// forwards to handlers are checked against the registered handlers and not charged the forward fee.
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"handler":"swap"}}`))
+	require.NoError(t, err)
+	require.Contains(t, res.Error, types.ErrUnknownForwardHandler.Error())
+
+	k.SetForwardHandler("swap", nil)
+	res, err = k.SimulateForward(ctx, request(`{"forward":{"handler":"swap"}}`))
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+	require.Equal(t, []types.SimulatedForward{{
+		Handler: "swap", Amount: sdkmath.NewInt(100), Fee: sdkmath.ZeroInt(), RelayerIncentive: sdkmath.ZeroInt(),
+	}}, res.Forwards)
+
+	_, err = k.SimulateForward(ctx, &types.QuerySimulateForwardRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
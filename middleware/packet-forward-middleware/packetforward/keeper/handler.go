@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// SetForwardHandler registers the handler that forward metadata selects with the given name.
+func (k *Keeper) SetForwardHandler(name string, handler types.ForwardHandler) {
+	k.forwardHandlers[name] = handler
+}
+
+// GetForwardHandler returns the forward handler registered with the given name, if any.
+func (k *Keeper) GetForwardHandler(name string) (types.ForwardHandler, bool) {
+	handler, found := k.forwardHandlers[name]
+	return handler, found
+}
+
+// HandleForward delivers the token received by the receiver from the inbound packet to the forward handler
+// selected by the metadata and returns the result of the handler. Unlike a transfer hop, the forward
+// completes immediately, so no in-flight packet is stored for it.
+func (k *Keeper) HandleForward(
+	ctx sdk.Context,
+	srcPacket channeltypes.Packet,
+	receiver string,
+	metadata *types.ForwardMetadata,
+	token sdk.Coin,
+) ([]byte, error) {
+	handler, found := k.GetForwardHandler(metadata.Handler)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrUnknownForwardHandler, metadata.Handler)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := k.nextMemo(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Debug("packetForwardMiddleware HandleForward",
+		"handler", metadata.Handler,
+		"sender", receiver, "receiver", metadata.Receiver,
+		"amount", token.Amount.String(), "denom", token.Denom,
+	)
+
+	result, err := handler.HandleForward(ctx, srcPacket, sender, token, metadata, memo)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware HandleForward error",
+			"handler", metadata.Handler,
+			"sender", receiver, "receiver", metadata.Receiver,
+			"amount", token.Amount.String(), "denom", token.Denom,
+			"error", err,
+		)
+		return nil, errorsmod.Wrapf(err, "forward handler %s failed", metadata.Handler)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardHandled{
+		InboundPortId:    srcPacket.DestinationPort,
+		InboundChannelId: srcPacket.DestinationChannel,
+		InboundSequence:  srcPacket.Sequence,
+		Handler:          metadata.Handler,
+		Receiver:         metadata.Receiver,
+		Denom:            token.Denom,
+		Amount:           token.Amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SetHandledSplitForwardResult records the successful forward to a handler of the split with the given index
+// of the inbound packet, so that the inbound packet is acknowledged once its other forwards complete.
+func (k *Keeper) SetHandledSplitForwardResult(
+	ctx sdk.Context,
+	srcPacket channeltypes.Packet,
+	split int,
+	metadata *types.ForwardMetadata,
+	token sdk.Coin,
+) {
+	inFlightPacket := &types.InFlightPacket{
+		RefundChannelId: srcPacket.DestinationChannel,
+		RefundPortId:    srcPacket.DestinationPort,
+		RefundSequence:  srcPacket.Sequence,
+	}
+
+	// handled forwards have no channel, port or sequence, their results are keyed by split instead.
+	k.setSplitForwardResult(ctx, inFlightPacket, types.SplitForwardResult{
+		Handler:  metadata.Handler,
+		Sequence: uint64(split),
+		Denom:    token.Denom,
+		Amount:   token.Amount.String(),
+		Success:  true,
+	})
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all packetforward invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "in-flight-packet-commitments", InFlightPacketCommitmentsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-escrow", TotalEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the packetforward module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := InFlightPacketCommitmentsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return TotalEscrowInvariant(k)(ctx)
+	}
+}
+
+// InFlightPacketCommitmentsInvariant checks that every in-flight packet has a packet commitment on its
+// outbound channel, i.e. that the forwarded packet can still be acknowledged or timed out.
+func InFlightPacketCommitmentsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		uncommitted := k.UncommittedInFlightPackets(ctx)
+		if len(uncommitted) == 0 {
+			return "", false
+		}
+
+		keys := make([]string, len(uncommitted))
+		for i, entry := range uncommitted {
+			keys[i] = string(types.RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence))
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"in-flight packet commitments",
+			fmt.Sprintf("found %d in-flight packet(s) without a packet commitment:\n%s", len(keys), strings.Join(keys, "\n"))), true
+	}
+}
+
+// TotalEscrowInvariant checks that the total escrow per denom tracked by the transfer module, which is
+// updated when the funds of failed forwards are moved out of escrow, is held by the escrow accounts of
+// the transfer channels.
+func TotalEscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalEscrowed, escrowBalances := k.EscrowTotals(ctx)
+		if escrowBalances.IsAllGTE(totalEscrowed) {
+			return "", false
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"total escrow",
+			fmt.Sprintf("found denom(s) with escrow balances lower than the total escrow:\nescrow balances: %s\ntotal escrowed: %s", escrowBalances, totalEscrowed)), true
+	}
+}
+
+// UncommittedInFlightPackets returns the in-flight packets without a packet commitment on their outbound channel.
+func (k *Keeper) UncommittedInFlightPackets(ctx sdk.Context) []types.InFlightPacketEntry {
+	var uncommitted []types.InFlightPacketEntry
+	k.IterateInFlightPackets(ctx, func(entry types.InFlightPacketEntry) bool {
+		if len(k.channelKeeper.GetPacketCommitment(ctx, entry.PortId, entry.ChannelId, entry.Sequence)) == 0 {
+			uncommitted = append(uncommitted, entry)
+		}
+		return false
+	})
+	return uncommitted
+}
+
+// EscrowTotals returns the total escrow per denom tracked by the transfer module and the sum of the balances
+// of the escrow accounts of the transfer channels.
+func (k *Keeper) EscrowTotals(ctx sdk.Context) (totalEscrowed, escrowBalances sdk.Coins) {
+	totalEscrowed = k.transferKeeper.GetAllTotalEscrowed(ctx)
+
+	portID := k.transferKeeper.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		escrowAddress := transfertypes.GetEscrowAddress(portID, channel.ChannelId)
+		escrowBalances = escrowBalances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return totalEscrowed, escrowBalances
+}
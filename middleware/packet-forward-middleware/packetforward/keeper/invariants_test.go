@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func TestInFlightPacketCommitmentsInvariant(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 1, inFlightPacket("channel-1", "cosmos1alice", false))
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 2, inFlightPacket("channel-1", "cosmos1bob", false))
+
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, "transfer", "channel-0", uint64(1)).Return([]byte{1}).Times(2)
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, "transfer", "channel-0", uint64(2)).Return([]byte{1})
+
+	_, broken := keeper.InFlightPacketCommitmentsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the commitment of the second packet was removed without the in-flight packet being resolved.
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, "transfer", "channel-0", uint64(2)).Return(nil)
+
+	msg, broken := keeper.InFlightPacketCommitmentsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "channel-0/transfer/2")
+	require.NotContains(t, msg, "channel-0/transfer/1")
+}
+
+func TestTotalEscrowInvariant(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	setup.Mocks.TransferKeeperMock.EXPECT().GetPort(ctx).Return("transfer").AnyTimes()
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetAllChannelsWithPortPrefix(ctx, "transfer").Return([]channeltypes.IdentifiedChannel{
+		{PortId: "transfer", ChannelId: "channel-0"},
+		{PortId: "transfer", ChannelId: "channel-1"},
+	}).AnyTimes()
+	setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, transfertypes.GetEscrowAddress("transfer", "channel-0")).
+		Return(sdk.NewCoins(sdk.NewInt64Coin("uatom", 60))).AnyTimes()
+	setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, transfertypes.GetEscrowAddress("transfer", "channel-1")).
+		Return(sdk.NewCoins(sdk.NewInt64Coin("uatom", 40), sdk.NewInt64Coin("uosmo", 10))).AnyTimes()
+
+	testCases := []struct {
+		name          string
+		totalEscrowed sdk.Coins
+		expBroken     bool
+	}{
+		{"escrow balances match total escrow", sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uosmo", 10)), false},
+		{"escrow balances exceed total escrow", sdk.NewCoins(sdk.NewInt64Coin("uatom", 90)), false},
+		{"total escrow exceeds escrow balances", sdk.NewCoins(sdk.NewInt64Coin("uatom", 101)), true},
+		{"total escrow of a denom without escrow balance", sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup.Mocks.TransferKeeperMock.EXPECT().GetAllTotalEscrowed(ctx).Return(tc.totalEscrowed).Times(2)
+
+			_, broken := keeper.TotalEscrowInvariant(k)(ctx)
+			require.Equal(t, tc.expBroken, broken)
+
+			res, err := k.Audit(ctx, &types.QueryAuditRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expBroken, res.Broken)
+			require.Equal(t, tc.totalEscrowed, res.TotalEscrowed)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uosmo", 10)), res.EscrowBalances)
+		})
+	}
+}
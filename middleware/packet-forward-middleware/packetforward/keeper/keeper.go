@@ -10,16 +10,18 @@ import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	"github.com/hashicorp/go-metrics"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -49,12 +51,36 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
+	schema          collections.Schema
+	params          collections.Item[types.Params]
+	inFlightPackets *types.InFlightPackets
+
+	// timeoutWatchdogTimestamp and timeoutWatchdogHeight are the inbound timeouts up to which the timeout
+	// watchdog has alerted of in-flight packets.
+	timeoutWatchdogTimestamp collections.Item[uint64]
+	timeoutWatchdogHeight    collections.Item[uint64]
+
 	transferKeeper types.TransferKeeper
 	channelKeeper  types.ChannelKeeper
 	distrKeeper    types.DistributionKeeper
 	bankKeeper     types.BankKeeper
 	ics4Wrapper    porttypes.ICS4Wrapper
 
+	// feeKeeper escrows the ICS-29 relayer incentives of forwarded packets, if set.
+	feeKeeper types.FeeKeeper
+
+	// adapters forward the packets of applications other than ICS-20, keyed by their port.
+	adapters map[string]types.PacketDataAdapter
+
+	// forwardHandlers deliver the funds of forwards to local modules, keyed by the name forward metadata selects them by.
+	forwardHandlers map[string]types.ForwardHandler
+
+	// recoveryAddressResolver resolves the account that receives the funds of failed nonrefundable forwards.
+	recoveryAddressResolver types.RecoveryAddressResolver
+
+	// defaultTimeouts are the retries and timeouts used if the params do not set any.
+	defaultTimeouts types.Timeouts
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -63,7 +89,7 @@ type Keeper struct {
 // NewKeeper creates a new forward Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec,
-	key storetypes.StoreKey,
+	key *storetypes.KVStoreKey,
 	transferKeeper types.TransferKeeper,
 	channelKeeper types.ChannelKeeper,
 	distrKeeper types.DistributionKeeper,
@@ -71,16 +97,42 @@ func NewKeeper(
 	ics4Wrapper porttypes.ICS4Wrapper,
 	authority string,
 ) *Keeper {
-	return &Keeper{
-		cdc:            cdc,
-		storeKey:       key,
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		distrKeeper:    distrKeeper,
-		bankKeeper:     bankKeeper,
-		ics4Wrapper:    ics4Wrapper,
-		authority:      authority,
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+
+	k := &Keeper{
+		cdc:             cdc,
+		storeKey:        key,
+		params:          collections.NewItem(sb, collections.NewPrefix(types.ParamsKey), "params", codec.CollValue[types.Params](cdc)),
+		inFlightPackets: types.NewInFlightPackets(sb, cdc),
+		timeoutWatchdogTimestamp: collections.NewItem(
+			sb, types.TimeoutWatchdogTimestampKey, "timeout_watchdog_timestamp", collections.Uint64Value,
+		),
+		timeoutWatchdogHeight: collections.NewItem(
+			sb, types.TimeoutWatchdogHeightKey, "timeout_watchdog_height", collections.Uint64Value,
+		),
+		transferKeeper:  transferKeeper,
+		channelKeeper:   channelKeeper,
+		distrKeeper:     distrKeeper,
+		bankKeeper:      bankKeeper,
+		ics4Wrapper:     ics4Wrapper,
+		adapters:        make(map[string]types.PacketDataAdapter),
+		forwardHandlers: make(map[string]types.ForwardHandler),
+		authority:       authority,
+
+		recoveryAddressResolver: types.DefaultRecoveryAddressResolver{},
+		defaultTimeouts: types.Timeouts{
+			ForwardTimeout: DefaultForwardTransferPacketTimeoutTimestamp,
+			RefundTimeout:  DefaultRefundTransferPacketTimeoutTimestamp,
+		},
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -93,12 +145,18 @@ func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
 	k.transferKeeper = transferKeeper
 }
 
+// SetRecoveryAddressResolver sets the resolver of the account that receives the funds of failed nonrefundable
+// forwards, replacing the default resolution of bech32 addresses.
+func (k *Keeper) SetRecoveryAddressResolver(resolver types.RecoveryAddressResolver) {
+	k.recoveryAddressResolver = resolver
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
 }
 
-// moveFundsToUserRecoverableAccount will move the funds from the escrow account to the user recoverable account
+// moveFundsToUserRecoverableAccount will move the funds from the escrow account to the user recoverable account and return that account.
 // this is only used when the maximum timeouts have been reached or there is an acknowledgement error and the packet is nonrefundable,
 // i.e. an operation has occurred to make the original packet funds inaccessible to the user, e.g. a swap.
 // We cannot refund the funds back to the original chain, so we move them to an account on this chain that the user can access.
@@ -107,69 +165,62 @@ func (k *Keeper) moveFundsToUserRecoverableAccount(
 	packet channeltypes.Packet,
 	data transfertypes.FungibleTokenPacketData,
 	inFlightPacket *types.InFlightPacket,
-) error {
+) (sdk.AccAddress, error) {
+	userAccount, err := k.recoveryAddressResolver.ResolveRecoveryAddress(ctx, inFlightPacket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user recoverable account: %w", err)
+	}
+
+	if _, err := k.moveForwardedFunds(ctx, packet, data, userAccount); err != nil {
+		return nil, err
+	}
+
+	return userAccount, nil
+}
+
+// moveForwardedFunds moves the funds of a failed forwarded packet from the escrow account, or mints them back if they
+// were burned, to the recipient on this chain and returns them.
+func (k *Keeper) moveForwardedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	recipient sdk.AccAddress,
+) (sdk.Coin, error) {
 	fullDenomPath := data.Denom
 
 	amount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
-		return fmt.Errorf("failed to parse amount from packet data for forward recovery: %s", data.Amount)
+		return sdk.Coin{}, fmt.Errorf("failed to parse amount from packet data for forward recovery: %s", data.Amount)
 	}
 	denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
 	token := sdk.NewCoin(denomTrace.IBCDenom(), amount)
 
-	userAccount, err := userRecoverableAccount(inFlightPacket)
-	if err != nil {
-		return fmt.Errorf("failed to get user recoverable account: %w", err)
-	}
-
 	if !transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) {
 		// mint vouchers back to sender
 		if err := k.bankKeeper.MintCoins(
 			ctx, transfertypes.ModuleName, sdk.NewCoins(token),
 		); err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, userAccount, sdk.NewCoins(token)); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, recipient, sdk.NewCoins(token)); err != nil {
 			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 		}
-		return nil
+		return token, nil
 	}
 
 	escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
 
 	if err := k.bankKeeper.SendCoins(
-		ctx, escrowAddress, userAccount, sdk.NewCoins(token),
+		ctx, escrowAddress, recipient, sdk.NewCoins(token),
 	); err != nil {
-		return fmt.Errorf("failed to send coins from escrow account to user recoverable account: %w", err)
+		return sdk.Coin{}, fmt.Errorf("failed to send coins from escrow account to user recoverable account: %w", err)
 	}
 
 	// update the total escrow amount for the denom.
 	k.unescrowToken(ctx, token)
 
-	return nil
-}
-
-// userRecoverableAccount finds an account on this chain that the original sender of the packet can recover funds from.
-// If the destination receiver of the original packet is a valid bech32 address for this chain, we use that address.
-// Otherwise, if the sender of the original packet is a valid bech32 address for another chain, we translate that address to this chain.
-// Note that for the fallback, the coin type of the source chain sender account must be compatible with this chain.
-func userRecoverableAccount(inFlightPacket *types.InFlightPacket) (sdk.AccAddress, error) {
-	var originalData transfertypes.FungibleTokenPacketData
-	err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &originalData)
-	if err == nil {
-		sender, err := sdk.AccAddressFromBech32(originalData.Receiver)
-		if err == nil {
-			return sender, nil
-		}
-	}
-
-	_, sender, fallbackErr := bech32.DecodeAndConvert(inFlightPacket.OriginalSenderAddress)
-	if fallbackErr == nil {
-		return sender, nil
-	}
-
-	return nil, fmt.Errorf("failed to decode bech32 addresses: %w", errors.Join(err, fallbackErr))
+	return token, nil
 }
 
 func (k *Keeper) WriteAcknowledgementForForwardedPacket(
@@ -179,6 +230,10 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 	inFlightPacket *types.InFlightPacket,
 	ack channeltypes.Acknowledgement,
 ) error {
+	if k.isSplitForward(ctx, inFlightPacket) {
+		return k.resolveSplitForward(ctx, packet, data, inFlightPacket, ack)
+	}
+
 	// Lookup module by channel capability
 	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
 	if err != nil {
@@ -194,86 +249,37 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 		if inFlightPacket.Nonrefundable {
 			// we are not allowed to refund back to the source chain.
 			// attempt to move funds to user recoverable account on this chain.
-			if err := k.moveFundsToUserRecoverableAccount(ctx, packet, data, inFlightPacket); err != nil {
+			if err := k.recoverForwardedFunds(ctx, packet, data, inFlightPacket, ack.GetError()); err != nil {
 				return err
 			}
 
 			ackResult := fmt.Sprintf("packet forward failed after point of no return: %s", ack.GetError())
 			newAck := channeltypes.NewResultAcknowledgement([]byte(ackResult))
 
-			return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
-				Data:               inFlightPacket.PacketData,
-				Sequence:           inFlightPacket.RefundSequence,
-				SourcePort:         inFlightPacket.PacketSrcPortId,
-				SourceChannel:      inFlightPacket.PacketSrcChannelId,
-				DestinationPort:    inFlightPacket.RefundPortId,
-				DestinationChannel: inFlightPacket.RefundChannelId,
-				TimeoutHeight:      clienttypes.MustParseHeight(inFlightPacket.PacketTimeoutHeight),
-				TimeoutTimestamp:   inFlightPacket.PacketTimeoutTimestamp,
-			}, newAck)
+			return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, newAck)
 		}
 
-		fullDenomPath := data.Denom
-
-		// deconstruct the token denomination into the denomination trace info
-		// to determine if the sender is the source chain
-		if strings.HasPrefix(data.Denom, "ibc/") {
-			fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, data.Denom)
-			if err != nil {
-				return err
-			}
+		if err := k.refundForwardedFunds(ctx, packet, data, inFlightPacket, inFlightPacket.RateLimitCharge, ack.GetError()); err != nil {
+			return err
 		}
+	}
 
-		if transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) {
-			// funds were moved to escrow account for transfer, so they need to either:
-			// - move to the other escrow account, in the case of native denom
-			// - burn
-
-			amount, ok := sdkmath.NewIntFromString(data.Amount)
-			if !ok {
-				return fmt.Errorf("failed to parse amount from packet data for forward refund: %s", data.Amount)
-			}
-			denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
-			token := sdk.NewCoin(denomTrace.IBCDenom(), amount)
-
-			escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
-
-			if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
-				// transfer funds from escrow account for forwarded packet to escrow account going back for refund.
-
-				refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
-
-				if err := k.bankKeeper.SendCoins(
-					ctx, escrowAddress, refundEscrowAddress, sdk.NewCoins(token),
-				); err != nil {
-					return fmt.Errorf("failed to send coins from escrow account to refund escrow account: %w", err)
-				}
-			} else {
-				// transfer the coins from the escrow account to the module account and burn them.
-
-				if err := k.bankKeeper.SendCoinsFromAccountToModule(
-					ctx, escrowAddress, transfertypes.ModuleName, sdk.NewCoins(token),
-				); err != nil {
-					return fmt.Errorf("failed to send coins from escrow to module account for burn: %w", err)
-				}
-
-				if err := k.bankKeeper.BurnCoins(
-					ctx, transfertypes.ModuleName, sdk.NewCoins(token),
-				); err != nil {
-					// NOTE: should not happen as the module account was
-					// retrieved on the step above and it has enough balace
-					// to burn.
-					panic(fmt.Sprintf("cannot burn coins after a successful send from escrow account to module account: %v", err))
-				}
-			}
+	return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+}
 
-			// We move funds from the escrowAddress in both cases,
-			// update the total escrow amount for the denom.
-			k.unescrowToken(ctx, token)
-		}
-	}
+// writeInboundAcknowledgement writes the acknowledgement for the inbound packet of an in-flight packet.
+func (k *Keeper) writeInboundAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, inboundPacket(inFlightPacket), ack)
+}
 
-	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
+// inboundPacket returns the inbound packet an in-flight packet was forwarded for.
+func inboundPacket(inFlightPacket *types.InFlightPacket) channeltypes.Packet {
+	return channeltypes.Packet{
 		Data:               inFlightPacket.PacketData,
 		Sequence:           inFlightPacket.RefundSequence,
 		SourcePort:         inFlightPacket.PacketSrcPortId,
@@ -282,7 +288,146 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 		DestinationChannel: inFlightPacket.RefundChannelId,
 		TimeoutHeight:      clienttypes.MustParseHeight(inFlightPacket.PacketTimeoutHeight),
 		TimeoutTimestamp:   inFlightPacket.PacketTimeoutTimestamp,
-	}, ack)
+	}
+}
+
+// recoverForwardedFunds moves the funds of a failed forwarded packet to an account on this chain the
+// original sender can recover them from, or holds them in a claim for the original sender if
+// Params.ClaimableRecovery is set.
+func (k *Keeper) recoverForwardedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ackErr string,
+) error {
+	var (
+		recoveryAddress sdk.AccAddress
+		claimID         uint64
+		err             error
+	)
+	if k.GetParams(ctx).ClaimableRecovery {
+		recoveryAddress = types.ClaimsEscrowAddress()
+		claimID, err = k.escrowRecoveredFunds(ctx, packet, data, inFlightPacket, ackErr)
+	} else {
+		recoveryAddress, err = k.moveFundsToUserRecoverableAccount(ctx, packet, data, inFlightPacket)
+	}
+	if err != nil {
+		return err
+	}
+
+	return emitForwardRecovered(ctx, packet, data, inFlightPacket, recoveryAddress, ackErr, claimID)
+}
+
+// emitForwardRecovered emits the event of the funds of a failed forwarded packet being moved to the recovery address.
+func emitForwardRecovered(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	recoveryAddress sdk.AccAddress,
+	ackErr string,
+	claimID uint64,
+) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardRecovered{
+		InboundPortId:     inFlightPacket.RefundPortId,
+		InboundChannelId:  inFlightPacket.RefundChannelId,
+		InboundSequence:   inFlightPacket.RefundSequence,
+		OutboundPortId:    packet.SourcePort,
+		OutboundChannelId: packet.SourceChannel,
+		OutboundSequence:  packet.Sequence,
+		Denom:             data.Denom,
+		Amount:            data.Amount,
+		RecoveryAddress:   recoveryAddress.String(),
+		Error:             ackErr,
+		ClaimId:           claimID,
+	})
+}
+
+// refundForwardedFunds returns the funds of a failed forwarded packet to where they were received from,
+// ahead of an error acknowledgement refunding them on the source chain.
+func (k *Keeper) refundForwardedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	charge *types.RateLimitCharge,
+	ackErr string,
+) error {
+	var err error
+	fullDenomPath := data.Denom
+
+	// deconstruct the token denomination into the denomination trace info
+	// to determine if the sender is the source chain
+	if strings.HasPrefix(data.Denom, "ibc/") {
+		fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, data.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	if transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) {
+		// funds were moved to escrow account for transfer, so they need to either:
+		// - move to the other escrow account, in the case of native denom
+		// - burn
+
+		amount, ok := sdkmath.NewIntFromString(data.Amount)
+		if !ok {
+			return fmt.Errorf("failed to parse amount from packet data for forward refund: %s", data.Amount)
+		}
+		denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
+		token := sdk.NewCoin(denomTrace.IBCDenom(), amount)
+
+		escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+
+		if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+			// transfer funds from escrow account for forwarded packet to escrow account going back for refund.
+
+			refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+
+			if err := k.bankKeeper.SendCoins(
+				ctx, escrowAddress, refundEscrowAddress, sdk.NewCoins(token),
+			); err != nil {
+				return fmt.Errorf("failed to send coins from escrow account to refund escrow account: %w", err)
+			}
+		} else {
+			// transfer the coins from the escrow account to the module account and burn them.
+
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(
+				ctx, escrowAddress, transfertypes.ModuleName, sdk.NewCoins(token),
+			); err != nil {
+				return fmt.Errorf("failed to send coins from escrow to module account for burn: %w", err)
+			}
+
+			if err := k.bankKeeper.BurnCoins(
+				ctx, transfertypes.ModuleName, sdk.NewCoins(token),
+			); err != nil {
+				// NOTE: should not happen as the module account was
+				// retrieved on the step above and it has enough balace
+				// to burn.
+				panic(fmt.Sprintf("cannot burn coins after a successful send from escrow account to module account: %v", err))
+			}
+		}
+
+		// We move funds from the escrowAddress in both cases,
+		// update the total escrow amount for the denom.
+		k.unescrowToken(ctx, token)
+	}
+
+	// the refunded funds did not leave this chain, so they no longer count against the rate limit.
+	k.releaseRateLimit(ctx, charge)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardRefunded{
+		InboundPortId:     inFlightPacket.RefundPortId,
+		InboundChannelId:  inFlightPacket.RefundChannelId,
+		InboundSequence:   inFlightPacket.RefundSequence,
+		OutboundPortId:    packet.SourcePort,
+		OutboundChannelId: packet.SourceChannel,
+		OutboundSequence:  packet.Sequence,
+		Denom:             data.Denom,
+		Amount:            data.Amount,
+		Error:             ackErr,
+	})
 }
 
 // unescrowToken will update the total escrow by deducting the unescrowed token
@@ -303,42 +448,54 @@ func (k *Keeper) ForwardTransferPacket(
 	token sdk.Coin,
 	maxRetries uint8,
 	timeout time.Duration,
+	backoff types.RetryBackoff,
 	labels []metrics.Label,
 	nonrefundable bool,
 ) error {
 	var err error
-	feeAmount := sdkmath.LegacyNewDecFromInt(token.Amount).Mul(k.GetFeePercentage(ctx)).RoundInt()
+	feeAmount := k.GetParams(ctx).ForwardFee(metadata.Channel, token)
+	if err := types.ValidateForwardFee(feeAmount, token); err != nil {
+		return err
+	}
 	packetAmount := token.Amount.Sub(feeAmount)
 	feeCoins := sdk.Coins{sdk.NewCoin(token.Denom, feeAmount)}
 	packetCoin := sdk.NewCoin(token.Denom, packetAmount)
 
+	isRetry := inFlightPacket != nil
+
+	// retries resend an amount that was already counted against the rate limit.
+	var rateLimitCharge *types.RateLimitCharge
+	if !isRetry {
+		rateLimitCharge, err = k.CheckAndUpdateRateLimit(ctx, metadata.Channel, packetCoin)
+		if err != nil {
+			return err
+		}
+	}
+
+	// part of the fee may be escrowed as a relayer incentive once the packet is sent, the rest is paid now.
+	incentive, incentiveFee, hasIncentive := k.relayerIncentive(ctx, metadata, sdk.NewCoin(token.Denom, feeAmount))
+	if hasIncentive {
+		feeCoins = feeCoins.Sub(incentiveFee.Total()...)
+	}
+
 	// pay fees
-	if feeAmount.IsPositive() {
+	if !feeCoins.IsZero() {
 		hostAccAddr, err := sdk.AccAddressFromBech32(receiver)
 		if err != nil {
 			return err
 		}
-		err = k.distrKeeper.FundCommunityPool(ctx, feeCoins, hostAccAddr)
+		err = k.payForwardFee(ctx, hostAccAddr, feeCoins)
 		if err != nil {
-			k.Logger(ctx).Error("packetForwardMiddleware error funding community pool",
+			k.Logger(ctx).Error("packetForwardMiddleware error paying forward fee",
 				"error", err,
 			)
 			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 		}
 	}
 
-	memo := ""
-
-	// set memo for next transfer with next from this transfer.
-	if metadata.Next != nil {
-		memoBz, err := json.Marshal(metadata.Next)
-		if err != nil {
-			k.Logger(ctx).Error("packetForwardMiddleware error marshaling next as JSON",
-				"error", err,
-			)
-			return errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, err.Error())
-		}
-		memo = string(memoBz)
+	memo, err := k.nextMemo(ctx, metadata)
+	if err != nil {
+		return err
 	}
 
 	msgTransfer := transfertypes.NewMsgTransfer(
@@ -373,11 +530,17 @@ func (k *Keeper) ForwardTransferPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
 
+	if hasIncentive {
+		if err := k.escrowRelayerIncentive(ctx, receiver, metadata, res.Sequence, incentive, incentiveFee); err != nil {
+			return err
+		}
+	}
+
 	// Store the following information in keeper:
 	// key - information about forwarded packet: src_channel (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence
 	// value - information about original packet for refunding if necessary: retries, srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
 
-	if inFlightPacket == nil {
+	if !isRetry {
 		inFlightPacket = &types.InFlightPacket{
 			PacketData:            srcPacket.Data,
 			OriginalSenderAddress: srcPacketSender,
@@ -393,15 +556,54 @@ func (k *Keeper) ForwardTransferPacket(
 			RetriesRemaining: int32(maxRetries),
 			Timeout:          uint64(timeout.Nanoseconds()),
 			Nonrefundable:    nonrefundable,
+			RetryBackoff:     backoff,
+			Denom:            packetCoin.Denom,
+			Amount:           packetCoin.Amount.String(),
+			RecoverAddress:   metadata.RecoverAddress,
+			RateLimitCharge:  rateLimitCharge,
 		}
 	} else {
 		inFlightPacket.RetriesRemaining--
+		inFlightPacket.RetriesAttempted++
+	}
+
+	k.SetInFlightPacket(ctx, metadata.Channel, metadata.Port, res.Sequence, *inFlightPacket)
+
+	if isRetry {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventForwardRetried{
+			InboundPortId:     inFlightPacket.RefundPortId,
+			InboundChannelId:  inFlightPacket.RefundChannelId,
+			InboundSequence:   inFlightPacket.RefundSequence,
+			OutboundPortId:    metadata.Port,
+			OutboundChannelId: metadata.Channel,
+			OutboundSequence:  res.Sequence,
+			Denom:             packetCoin.Denom,
+			Amount:            packetCoin.Amount.String(),
+			Fee:               feeAmount.String(),
+			RetriesRemaining:  inFlightPacket.RetriesRemaining,
+		})
+	} else {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventForwardInitiated{
+			InboundPortId:     inFlightPacket.RefundPortId,
+			InboundChannelId:  inFlightPacket.RefundChannelId,
+			InboundSequence:   inFlightPacket.RefundSequence,
+			OutboundPortId:    metadata.Port,
+			OutboundChannelId: metadata.Channel,
+			OutboundSequence:  res.Sequence,
+			Receiver:          metadata.Receiver,
+			Denom:             packetCoin.Denom,
+			Amount:            packetCoin.Amount.String(),
+			Fee:               feeAmount.String(),
+			RelayerIncentive:  incentiveFee.Total().String(),
+		})
+	}
+	if err != nil {
+		return err
 	}
 
-	key := types.RefundPacketKey(metadata.Channel, metadata.Port, res.Sequence)
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(inFlightPacket)
-	store.Set(key, bz)
+	if err := k.alertReachedInboundTimeout(ctx, metadata.Channel, metadata.Port, res.Sequence, *inFlightPacket); err != nil {
+		return err
+	}
 
 	defer func() {
 		if token.Amount.IsInt64() {
@@ -421,26 +623,53 @@ func (k *Keeper) ForwardTransferPacket(
 	return nil
 }
 
+// nextMemo returns the memo of the packet forwarded with the metadata, which carries the metadata for the next hop.
+func (k *Keeper) nextMemo(ctx sdk.Context, metadata *types.ForwardMetadata) (string, error) {
+	if metadata.Next == nil {
+		return "", nil
+	}
+
+	memoBz, err := json.Marshal(metadata.Next)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware error marshaling next as JSON",
+			"error", err,
+		)
+		return "", errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return string(memoBz), nil
+}
+
+// payForwardFee sends the fee taken from a forwarded packet to the configured fee recipient,
+// or to the community pool if none is configured.
+func (k *Keeper) payForwardFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	recipient := k.GetParams(ctx).FeeRecipient
+	if recipient == "" {
+		return k.distrKeeper.FundCommunityPool(ctx, fee, payer)
+	}
+
+	if recipientAddr, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		return k.bankKeeper.SendCoins(ctx, payer, recipientAddr, fee)
+	}
+
+	// params validation guarantees the recipient is otherwise a module account name. The fee is sent
+	// to the module address directly, so a module account that does not exist yet cannot halt the forward.
+	return k.bankKeeper.SendCoins(ctx, payer, authtypes.NewModuleAddress(recipient), fee)
+}
+
 // TimeoutShouldRetry returns inFlightPacket and no error if retry should be attempted. Error is returned if IBC refund should occur.
 func (k *Keeper) TimeoutShouldRetry(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 ) (*types.InFlightPacket, error) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
-
-	if !store.Has(key) {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
 		// not a forwarded packet, ignore.
 		return nil, nil
 	}
 
-	bz := store.Get(key)
-	var inFlightPacket types.InFlightPacket
-	k.cdc.MustUnmarshal(bz, &inFlightPacket)
-
 	if inFlightPacket.RetriesRemaining <= 0 {
 		k.Logger(ctx).Error("packetForwardMiddleware reached max retries for packet",
-			"key", string(key),
+			"key", string(types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)),
 			"original-sender-address", inFlightPacket.OriginalSenderAddress,
 			"refund-channel-id", inFlightPacket.RefundChannelId,
 			"refund-port-id", inFlightPacket.RefundPortId,
@@ -449,6 +678,12 @@ func (k *Keeper) TimeoutShouldRetry(
 			inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId)
 	}
 
+	// packets timed out on close cannot be sent on their closed channel again.
+	if channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel); found && channel.State == channeltypes.CLOSED {
+		return &inFlightPacket, fmt.Errorf("giving up on packet on channel (%s) port (%s) after outbound channel %s closed",
+			inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, packet.SourceChannel)
+	}
+
 	return &inFlightPacket, nil
 }
 
@@ -488,6 +723,12 @@ func (k *Keeper) RetryTimeout(
 
 	token := sdk.NewCoin(denom, amount)
 
+	// the timeout of each retry grows from the initial timeout according to the backoff policy.
+	timeout := inFlightPacket.RetryBackoff.Timeout(
+		time.Duration(inFlightPacket.Timeout)*time.Nanosecond,
+		inFlightPacket.RetriesAttempted+1,
+	)
+
 	// srcPacket and srcPacketSender are empty because inFlightPacket is non-nil.
 	return k.ForwardTransferPacket(
 		ctx,
@@ -498,22 +739,41 @@ func (k *Keeper) RetryTimeout(
 		metadata,
 		token,
 		uint8(inFlightPacket.RetriesRemaining),
-		time.Duration(inFlightPacket.Timeout)*time.Nanosecond,
+		timeout,
+		inFlightPacket.RetryBackoff,
 		nil,
 		inFlightPacket.Nonrefundable,
 	)
 }
 
 func (k *Keeper) RemoveInFlightPacket(ctx sdk.Context, packet channeltypes.Packet) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
-	if !store.Has(key) {
+	_, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
 		// not a forwarded packet, ignore.
 		return
 	}
 
 	// done with packet key now, delete.
-	store.Delete(key)
+	k.deleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+}
+
+// GetInFlightPacket returns the InFlightPacket stored for the forwarded packet
+// with the given channel, port and sequence, if any.
+func (k *Keeper) GetInFlightPacket(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+) (types.InFlightPacket, bool) {
+	inFlightPacket, err := k.inFlightPackets.Get(ctx, collections.Join3(channel, port, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.InFlightPacket{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return inFlightPacket, true
 }
 
 // GetAndClearInFlightPacket will fetch an InFlightPacket from the store, remove it if it exists, and return it.
@@ -523,23 +783,117 @@ func (k *Keeper) GetAndClearInFlightPacket(
 	port string,
 	sequence uint64,
 ) *types.InFlightPacket {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(channel, port, sequence)
-	if !store.Has(key) {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, channel, port, sequence)
+	if !found {
 		// this is either not a forwarded packet, or it is the final destination for the refund.
 		return nil
 	}
 
-	bz := store.Get(key)
-
 	// done with packet key now, delete.
-	store.Delete(key)
+	k.deleteInFlightPacket(ctx, channel, port, sequence)
 
-	var inFlightPacket types.InFlightPacket
-	k.cdc.MustUnmarshal(bz, &inFlightPacket)
 	return &inFlightPacket
 }
 
+// SetInFlightPacket stores the InFlightPacket for the forwarded packet with the given channel, port and sequence,
+// and indexes it under the inbound packet it was produced by.
+func (k *Keeper) SetInFlightPacket(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+	inFlightPacket types.InFlightPacket,
+) {
+	if err := k.inFlightPackets.Set(ctx, collections.Join3(channel, port, sequence), inFlightPacket); err != nil {
+		panic(err)
+	}
+}
+
+// deleteInFlightPacket removes the InFlightPacket for the forwarded packet with the given channel, port and sequence,
+// along with its inbound packet index entry.
+func (k *Keeper) deleteInFlightPacket(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+) {
+	if err := k.inFlightPackets.Remove(ctx, collections.Join3(channel, port, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// IterateInFlightPackets calls cb with every in-flight packet, in order of the channel, port and sequence of the
+// forwarded packet, until cb returns true.
+func (k *Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(entry types.InFlightPacketEntry) (stop bool)) {
+	k.walkInFlightPackets(ctx, nil, cb)
+}
+
+// IterateInFlightPacketsOnChannel calls cb with every in-flight packet forwarded on the given channel and port,
+// in order of sequence, until cb returns true.
+func (k *Keeper) IterateInFlightPacketsOnChannel(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	cb func(entry types.InFlightPacketEntry) (stop bool),
+) {
+	k.walkInFlightPackets(ctx, collections.NewSuperPrefixedTripleRange[string, string, uint64](channel, port), cb)
+}
+
+func (k *Keeper) walkInFlightPackets(
+	ctx sdk.Context,
+	ranger collections.Ranger[types.PacketKey],
+	cb func(entry types.InFlightPacketEntry) (stop bool),
+) {
+	err := k.inFlightPackets.Walk(ctx, ranger, func(key types.PacketKey, inFlightPacket types.InFlightPacket) (bool, error) {
+		return cb(inFlightPacketEntry(key, inFlightPacket)), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetInFlightPacketsByInboundPacket returns the forwarded packets that are still in flight for the
+// inbound packet received on the given channel and port with the given sequence.
+func (k *Keeper) GetInFlightPacketsByInboundPacket(
+	ctx sdk.Context,
+	inboundChannel string,
+	inboundPort string,
+	inboundSequence uint64,
+) []types.InFlightPacketEntry {
+	itr, err := k.inFlightPackets.Indexes.Inbound.MatchExact(ctx, collections.Join3(inboundChannel, inboundPort, inboundSequence))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	var entries []types.InFlightPacketEntry
+	for ; itr.Valid(); itr.Next() {
+		key, err := itr.PrimaryKey()
+		if err != nil {
+			panic(err)
+		}
+
+		inFlightPacket, found := k.GetInFlightPacket(ctx, key.K1(), key.K2(), key.K3())
+		if !found {
+			continue
+		}
+
+		entries = append(entries, inFlightPacketEntry(key, inFlightPacket))
+	}
+
+	return entries
+}
+
+// inFlightPacketEntry pairs the in-flight packet with the channel, port and sequence it is stored under.
+func inFlightPacketEntry(key types.PacketKey, inFlightPacket types.InFlightPacket) types.InFlightPacketEntry {
+	return types.InFlightPacketEntry{
+		ChannelId:      key.K1(),
+		PortId:         key.K2(),
+		Sequence:       key.K3(),
+		InFlightPacket: inFlightPacket,
+	}
+}
+
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
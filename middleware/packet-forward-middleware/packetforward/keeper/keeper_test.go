@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestRetryTimeoutBackoff(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := setup.Keepers.PacketForwardKeeper
+
+	sender := test.AccAddress().String()
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, "cosmos1receiver", "")
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.Timeout = uint64(10 * time.Minute)
+	packet.RetriesRemaining = 2
+	packet.RetriesAttempted = 1
+	packet.RetryBackoff = types.RetryBackoff{Strategy: types.BackoffExponential, MaxTimeout: time.Hour}
+
+	// the second retry doubles the initial timeout twice.
+	setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+		sdk.WrapSDKContext(ctx),
+		transfertypes.NewMsgTransfer(
+			"transfer",
+			"channel-0",
+			sdk.NewInt64Coin("uatom", 100),
+			sender,
+			"cosmos1receiver",
+			keeper.DefaultTransferPacketTimeoutHeight,
+			uint64(ctx.BlockTime().Add(40*time.Minute).UnixNano()),
+			"",
+		),
+	).Return(&transfertypes.MsgTransferResponse{Sequence: 5}, nil)
+
+	require.NoError(t, k.RetryTimeout(ctx, "channel-0", "transfer", data, &packet))
+
+	retried, found := k.GetInFlightPacket(ctx, "channel-0", "transfer", 5)
+	require.True(t, found)
+	require.Equal(t, int32(1), retried.RetriesRemaining)
+	require.Equal(t, uint32(2), retried.RetriesAttempted)
+	require.Equal(t, uint64(10*time.Minute), retried.Timeout)
+}
+
+func TestInFlightPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	split := inFlightPacket("channel-1", "cosmos1alice", false)
+	split.RefundSequence = 7
+	other := inFlightPacket("channel-1", "cosmos1bob", false)
+	other.RefundSequence = 8
+
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 1, split)
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 2, other)
+	k.SetInFlightPacket(ctx, "channel-2", "transfer", 3, split)
+
+	var onChannel []uint64
+	k.IterateInFlightPacketsOnChannel(ctx, "channel-0", "transfer", func(entry types.InFlightPacketEntry) bool {
+		onChannel = append(onChannel, entry.Sequence)
+		return false
+	})
+	require.Equal(t, []uint64{1, 2}, onChannel)
+
+	entries := k.GetInFlightPacketsByInboundPacket(ctx, "channel-1", "transfer", 7)
+	require.Len(t, entries, 2)
+	require.Equal(t, "channel-0", entries[0].ChannelId)
+	require.Equal(t, "channel-2", entries[1].ChannelId)
+
+	// clearing an in-flight packet also removes it from the index by inbound packet.
+	require.NotNil(t, k.GetAndClearInFlightPacket(ctx, "channel-0", "transfer", 1))
+	entries = k.GetInFlightPacketsByInboundPacket(ctx, "channel-1", "transfer", 7)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(3), entries[0].Sequence)
+	require.Nil(t, k.GetAndClearInFlightPacket(ctx, "channel-0", "transfer", 1))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// DetectForwardLoop returns an error if forwarding the tokens of a received packet with the given denom
+// according to the metadata would send them back over the channel they were received on, or to a chain
+// they were received from. The chains the tokens passed through are identified by the chain id of the
+// counterparty light client of the channels in their denom trace that are known to this chain: the channel
+// the packet was received on and, for tokens unwinding to this chain, the channel they were received on before.
+// Forwarding unwinding tokens over the channel they were received on before returns them along their trace
+// and is not a loop.
+func (k *Keeper) DetectForwardLoop(ctx sdk.Context, packet channeltypes.Packet, denom string, metadata *types.ForwardMetadata) error {
+	if metadata.Port == packet.DestinationPort && metadata.Channel == packet.DestinationChannel {
+		return errorsmod.Wrapf(types.ErrForwardLoop, "forward back over inbound channel %s", packet.DestinationChannel)
+	}
+
+	chainID, found := k.counterpartyChainID(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return nil
+	}
+
+	// the channels of this chain the tokens were received on.
+	visited := [][2]string{{packet.DestinationPort, packet.DestinationChannel}}
+	if prefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel); strings.HasPrefix(denom, prefix) {
+		trace := strings.SplitN(transfertypes.ParseDenomTrace(denom[len(prefix):]).Path, "/", 3)
+		if len(trace) >= 2 && (trace[0] != metadata.Port || trace[1] != metadata.Channel) {
+			visited = append(visited, [2]string{trace[0], trace[1]})
+		}
+	}
+
+	for _, hop := range visited {
+		if visitedChainID, found := k.counterpartyChainID(ctx, hop[0], hop[1]); found && visitedChainID == chainID {
+			return errorsmod.Wrapf(types.ErrForwardLoop, "forward on %s returns to chain %s, already visited through %s", metadata.Channel, chainID, hop[1])
+		}
+	}
+
+	return nil
+}
+
+// counterpartyChainID returns the chain id of the counterparty chain of a channel, if it is connected through a
+// tendermint light client.
+func (k *Keeper) counterpartyChainID(ctx sdk.Context, port, channel string) (string, bool) {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, port, channel)
+	if err != nil {
+		return "", false
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return "", false
+	}
+	return tmClientState.ChainId, true
+}
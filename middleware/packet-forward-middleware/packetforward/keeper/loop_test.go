@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func TestDetectForwardLoop(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.PacketForwardKeeper
+
+	// packets are received on channel-11 from chain-a, tokens unwinding to this chain were received from chain-z on channel-2 before.
+	setup.ChainIDs["channel-11"] = "chain-a"
+	setup.ChainIDs["channel-0"] = "chain-c"
+	setup.ChainIDs["channel-1"] = "chain-a"
+	setup.ChainIDs["channel-2"] = "chain-z"
+	setup.ChainIDs["channel-3"] = "chain-z"
+
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-10",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-11",
+	}
+
+	testCases := []struct {
+		name    string
+		denom   string
+		port    string
+		channel string
+		expErr  bool
+	}{
+		{"forward to another chain", "uatom", "transfer", "channel-0", false},
+		{"forward to a chain without a tendermint client", "uatom", "transfer", "channel-9", false},
+		{"forward back over the inbound channel", "uatom", "transfer", "channel-11", true},
+		{"forward back to the sender over another channel", "uatom", "transfer", "channel-1", true},
+		{"unwinding tokens forwarded along their trace", "transfer/channel-10/transfer/channel-2/uatom", "transfer", "channel-2", false},
+		{"unwinding tokens forwarded back to a chain they were received from", "transfer/channel-10/transfer/channel-2/uatom", "transfer", "channel-3", true},
+		{"unwinding native tokens", "transfer/channel-10/uatom", "transfer", "channel-2", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := &types.ForwardMetadata{Receiver: "cosmos1", Port: tc.port, Channel: tc.channel}
+			err := k.DetectForwardLoop(ctx, packet, tc.denom, metadata)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrForwardLoop)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/exported"
 	v2 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/migrations/v2"
+	v3 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/migrations/v3"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +28,11 @@ func NewMigrator(k *Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the module state from the consensus version 2 to
+// version 3. Specifically, it moves the in-flight packets from their raw keys
+// into the in-flight packets collection and its indexes, and sets the params
+// added since version 2 to their upgrade values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc, m.keeper.inFlightPackets)
+}
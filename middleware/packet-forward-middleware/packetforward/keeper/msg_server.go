@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -37,3 +38,78 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ResetRateLimit implements types.MsgServer.
+func (ms msgServer) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := ms.GetParams(ctx).RateLimit(req.ChannelId, req.Denom); !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no rate limit for channel %s denom %s", req.ChannelId, req.Denom)
+	}
+
+	ms.Keeper.ResetRateLimit(ctx, req.ChannelId, req.Denom)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// ForceRefundInFlightPacket implements types.MsgServer.
+func (ms msgServer) ForceRefundInFlightPacket(
+	goCtx context.Context,
+	req *types.MsgForceRefundInFlightPacket,
+) (*types.MsgForceRefundInFlightPacketResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ForceRefundInFlightPacket(ctx, req.ChannelId, req.PortId, req.Sequence, errForceRefunded); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceRefundInFlightPacketResponse{}, nil
+}
+
+// ClearInFlightPacket implements types.MsgServer.
+func (ms msgServer) ClearInFlightPacket(goCtx context.Context, req *types.MsgClearInFlightPacket) (*types.MsgClearInFlightPacketResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ClearInFlightPacket(ctx, req.ChannelId, req.PortId, req.Sequence); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearInFlightPacketResponse{}, nil
+}
+
+// ClaimRecoveredFunds implements types.MsgServer.
+func (ms msgServer) ClaimRecoveredFunds(goCtx context.Context, req *types.MsgClaimRecoveredFunds) (*types.MsgClaimRecoveredFundsResponse, error) {
+	claimer, err := sdk.AccAddressFromBech32(req.Claimer)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid claimer address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := ms.Keeper.ClaimRecoveredFunds(ctx, claimer, req.OriginalSenderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRecoveredFundsResponse{Amount: amount}, nil
+}
+
+// SetClaimDelegate implements types.MsgServer.
+func (ms msgServer) SetClaimDelegate(goCtx context.Context, req *types.MsgSetClaimDelegate) (*types.MsgSetClaimDelegateResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.Keeper.SetClaimDelegate(ctx, req.OriginalSenderAddress, req.Delegate)
+
+	return &types.MsgSetClaimDelegateResponse{}, nil
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,23 +17,25 @@ func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 		return err
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&p)
-	store.Set(types.ParamsKey, bz)
+	if err := k.params.Set(ctx, p); err != nil {
+		return err
+	}
+
+	// the usage of removed rate limits is dropped, so a limit added again later starts from zero.
+	k.pruneRateLimitFlows(ctx, p.RateLimits)
 	return nil
 }
 
 // GetParams returns the current module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	var p types.Params
-
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return p
+	p, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Params{}
+	}
+	if err != nil {
+		panic(err)
 	}
 
-	k.cdc.MustUnmarshal(bz, &p)
 	return p
 }
 
@@ -38,3 +43,16 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 func (k Keeper) GetFeePercentage(ctx sdk.Context) sdkmath.LegacyDec {
 	return k.GetParams(ctx).FeePercentage
 }
+
+// SetDefaultTimeouts sets the retries and timeouts used if the params do not set any.
+func (k *Keeper) SetDefaultTimeouts(timeouts types.Timeouts) {
+	k.defaultTimeouts = timeouts
+}
+
+// GetTimeouts returns the retries and timeouts set in the params, or otherwise the default ones.
+func (k Keeper) GetTimeouts(params types.Params) types.Timeouts {
+	if params.Timeouts != nil {
+		return *params.Timeouts
+	}
+	return k.defaultTimeouts
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckAndUpdateRateLimit adds the token to the amount forwarded in the current window of the
+// rate limit for the outbound channel and denom, if one is configured. An error is returned
+// without updating the amount if it would exceed the rate limit. The returned charge records
+// what was counted, it is nil if no rate limit is configured.
+func (k *Keeper) CheckAndUpdateRateLimit(ctx sdk.Context, channel string, token sdk.Coin) (*types.RateLimitCharge, error) {
+	limit, found := k.GetParams(ctx).RateLimit(channel, token.Denom)
+	if !found {
+		return nil, nil
+	}
+
+	flow := k.currentRateLimitFlow(ctx, limit)
+	amount := flow.Amount.Add(token.Amount)
+	if amount.GT(limit.MaxAmount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"forwarding %s on %s would exceed %s%s per %s, remaining %s",
+			token, channel, limit.MaxAmount, limit.Denom, limit.Period, limit.MaxAmount.Sub(flow.Amount),
+		)
+	}
+
+	flow.Amount = amount
+	k.setRateLimitFlow(ctx, channel, token.Denom, flow)
+	return &types.RateLimitCharge{
+		ChannelId:   channel,
+		Denom:       token.Denom,
+		Amount:      token.Amount,
+		WindowStart: flow.WindowStart,
+	}, nil
+}
+
+// releaseRateLimit gives back the amount of a refunded forward to the rate limit it was counted
+// against. Nothing is given back once the window it was counted in has elapsed or been reset.
+func (k *Keeper) releaseRateLimit(ctx sdk.Context, charge *types.RateLimitCharge) {
+	if charge == nil {
+		return
+	}
+
+	limit, found := k.GetParams(ctx).RateLimit(charge.ChannelId, charge.Denom)
+	if !found {
+		return
+	}
+
+	flow := k.currentRateLimitFlow(ctx, limit)
+	if !flow.WindowStart.Equal(charge.WindowStart) {
+		return
+	}
+
+	flow.Amount = sdkmath.MaxInt(flow.Amount.Sub(charge.Amount), sdkmath.ZeroInt())
+	k.setRateLimitFlow(ctx, charge.ChannelId, charge.Denom, flow)
+}
+
+// pruneRateLimitFlows deletes the stored flows of rate limits that are no longer configured.
+func (k *Keeper) pruneRateLimitFlows(ctx sdk.Context, limits []types.RateLimit) {
+	configured := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		configured[string(types.RateLimitFlowKey(limit.ChannelId, limit.Denom))] = true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.RateLimitFlowPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		if !configured[string(iterator.Key())] {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ResetRateLimit clears the amount forwarded in the current window of the rate limit for the
+// outbound channel and denom.
+func (k *Keeper) ResetRateLimit(ctx sdk.Context, channel, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitFlowKey(channel, denom))
+}
+
+// GetRateLimitQuota returns the usage of the rate limit in its current window.
+func (k *Keeper) GetRateLimitQuota(ctx sdk.Context, limit types.RateLimit) types.RateLimitQuota {
+	flow := k.currentRateLimitFlow(ctx, limit)
+	return types.RateLimitQuota{
+		RateLimit: limit,
+		Used:      flow.Amount,
+		Remaining: sdkmath.MaxInt(limit.MaxAmount.Sub(flow.Amount), sdkmath.ZeroInt()),
+		WindowEnd: flow.WindowStart.Add(limit.Period),
+	}
+}
+
+// currentRateLimitFlow returns the stored flow of the rate limit, or a new empty flow starting at
+// the current block time if none is stored or the stored window has elapsed.
+func (k *Keeper) currentRateLimitFlow(ctx sdk.Context, limit types.RateLimit) types.RateLimitFlow {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitFlowKey(limit.ChannelId, limit.Denom))
+	if bz != nil {
+		var flow types.RateLimitFlow
+		k.cdc.MustUnmarshal(bz, &flow)
+		if ctx.BlockTime().Before(flow.WindowStart.Add(limit.Period)) {
+			return flow
+		}
+	}
+
+	return types.RateLimitFlow{
+		WindowStart: ctx.BlockTime(),
+		Amount:      sdkmath.ZeroInt(),
+	}
+}
+
+func (k *Keeper) setRateLimitFlow(ctx sdk.Context, channel, denom string, flow types.RateLimitFlow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitFlowKey(channel, denom), k.cdc.MustMarshal(&flow))
+}
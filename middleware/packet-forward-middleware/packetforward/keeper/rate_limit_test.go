@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestRateLimit(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := setup.Keepers.PacketForwardKeeper
+
+	limit := types.RateLimit{ChannelId: "channel-0", Denom: "uatom", MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// denoms and channels without a rate limit are not limited.
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-1", sdk.NewInt64Coin("uatom", 1000))))
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uosmo", 1000))))
+
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 60))))
+	require.ErrorIs(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 41))), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 40))))
+
+	res, err := k.RateLimitQuotas(ctx, &types.QueryRateLimitQuotasRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimitQuota{{
+		RateLimit: limit,
+		Used:      sdkmath.NewInt(100),
+		Remaining: sdkmath.ZeroInt(),
+		WindowEnd: ctx.BlockTime().Add(time.Hour),
+	}}, res.Quotas)
+
+	res, err = k.RateLimitQuotas(ctx, &types.QueryRateLimitQuotasRequest{Denom: "uosmo"})
+	require.NoError(t, err)
+	require.Empty(t, res.Quotas)
+
+	// the used amount resets once the window has elapsed.
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	quota := k.GetRateLimitQuota(later, limit)
+	require.Equal(t, sdkmath.ZeroInt(), quota.Used)
+	require.Equal(t, later.BlockTime().Add(time.Hour), quota.WindowEnd)
+
+	// the authority may reset the window early.
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err = msgServer.ResetRateLimit(ctx, &types.MsgResetRateLimit{Authority: test.AccAddress().String(), ChannelId: "channel-0", Denom: "uatom"})
+	require.Error(t, err)
+	_, err = msgServer.ResetRateLimit(ctx, &types.MsgResetRateLimit{Authority: k.GetAuthority(), ChannelId: "channel-1", Denom: "uatom"})
+	require.Error(t, err)
+	_, err = msgServer.ResetRateLimit(ctx, &types.MsgResetRateLimit{Authority: k.GetAuthority(), ChannelId: "channel-0", Denom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), k.GetRateLimitQuota(ctx, limit).Remaining)
+}
+
+func TestRateLimitReleasedOnRefund(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := setup.Keepers.PacketForwardKeeper
+
+	limit := types.RateLimit{ChannelId: "channel-0", Denom: "uatom", MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+	charge, err := k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 60))
+	require.NoError(t, err)
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 30))))
+
+	// the amount of a retried forward may be reduced by the forward fee charged again,
+	// the amount counted is given back all the same.
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.Denom = "uatom"
+	packet.Amount = "55"
+	packet.RateLimitCharge = charge
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	chanCap := capabilitytypes.NewCapability(1)
+	setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+		Return(transfertypes.ModuleName, chanCap, nil)
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 1000))
+	setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 945))
+	setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).Return(nil)
+
+	require.NoError(t, k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", 5, errors.New("stuck")))
+	require.Equal(t, sdkmath.NewInt(30), k.GetRateLimitQuota(ctx, limit).Used)
+}
+
+func TestRateLimitNotReleasedAfterWindow(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := setup.Keepers.PacketForwardKeeper
+
+	limit := types.RateLimit{ChannelId: "channel-0", Denom: "uatom", MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+	charge, err := k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 60))
+	require.NoError(t, err)
+
+	packet := inFlightPacket("channel-1", "cosmos1alice", false)
+	packet.Denom = "uatom"
+	packet.Amount = "60"
+	packet.RateLimitCharge = charge
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, packet)
+
+	// the forward is refunded in the next window, which already counts other forwards.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 80))))
+
+	chanCap := capabilitytypes.NewCapability(1)
+	setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "transfer", "channel-1").
+		Return(transfertypes.ModuleName, chanCap, nil)
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 1000))
+	setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin("uatom", 940))
+	setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).Return(nil)
+
+	require.NoError(t, k.ForceRefundInFlightPacket(ctx, "channel-0", "transfer", 5, errors.New("stuck")))
+	require.Equal(t, sdkmath.NewInt(80), k.GetRateLimitQuota(ctx, limit).Used)
+}
+
+func TestRateLimitFlowsPrunedWithLimit(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := setup.Keepers.PacketForwardKeeper
+
+	limit := types.RateLimit{ChannelId: "channel-0", Denom: "uatom", MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	other := types.RateLimit{ChannelId: "channel-1", Denom: "uatom", MaxAmount: sdkmath.NewInt(100), Period: time.Hour}
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{limit, other}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-0", sdk.NewInt64Coin("uatom", 60))))
+	require.NoError(t, rateLimitErr(k.CheckAndUpdateRateLimit(ctx, "channel-1", sdk.NewInt64Coin("uatom", 30))))
+
+	// removing a limit drops its usage, the usage of the remaining limits is kept.
+	params.RateLimits = []types.RateLimit{other}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, sdkmath.ZeroInt(), k.GetRateLimitQuota(ctx, limit).Used)
+	require.Equal(t, sdkmath.NewInt(30), k.GetRateLimitQuota(ctx, other).Used)
+}
+
+// rateLimitErr returns the error of a rate limit check, dropping the recorded charge.
+func rateLimitErr(_ *types.RateLimitCharge, err error) error {
+	return err
+}
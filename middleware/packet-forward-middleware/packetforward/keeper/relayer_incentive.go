@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// SetFeeKeeper sets the ICS-29 fee keeper used to escrow relayer incentives. Without it, relayer incentives
+// configured in params are not escrowed and the whole forward fee is paid to the fee recipient.
+func (k *Keeper) SetFeeKeeper(feeKeeper types.FeeKeeper) {
+	k.feeKeeper = feeKeeper
+}
+
+// relayerIncentive returns the relayer incentive for the packet forwarded with the metadata and the ICS-29 fee
+// it carves out of the forward fee, if an incentive is configured for the outbound channel and it is fee enabled.
+func (k *Keeper) relayerIncentive(
+	ctx sdk.Context,
+	metadata *types.ForwardMetadata,
+	forwardFee sdk.Coin,
+) (types.RelayerIncentive, feetypes.Fee, bool) {
+	if k.feeKeeper == nil || !forwardFee.IsPositive() {
+		return types.RelayerIncentive{}, feetypes.Fee{}, false
+	}
+
+	incentive, found := k.GetParams(ctx).RelayerIncentive(metadata.Channel)
+	if !found {
+		return types.RelayerIncentive{}, feetypes.Fee{}, false
+	}
+
+	if !k.feeKeeper.IsFeeEnabled(ctx, metadata.Port, metadata.Channel) {
+		k.Logger(ctx).Debug("packetForwardMiddleware relayer incentive skipped, channel is not fee enabled",
+			"port", metadata.Port, "channel", metadata.Channel,
+		)
+		return types.RelayerIncentive{}, feetypes.Fee{}, false
+	}
+
+	fee := incentive.Fee(forwardFee)
+	if fee.Total().IsZero() {
+		return types.RelayerIncentive{}, feetypes.Fee{}, false
+	}
+
+	return incentive, fee, true
+}
+
+// escrowRelayerIncentive escrows the fee carved out of the forward fee by the incentive as the ICS-29 fee of the
+// forwarded packet. The fee is paid from the refund address of the incentive, which unused fees are refunded to.
+func (k *Keeper) escrowRelayerIncentive(
+	ctx sdk.Context,
+	payer string,
+	metadata *types.ForwardMetadata,
+	sequence uint64,
+	incentive types.RelayerIncentive,
+	fee feetypes.Fee,
+) error {
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return err
+	}
+	refundAddr, err := sdk.AccAddressFromBech32(incentive.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	// ICS-29 escrows the fee from the refund address.
+	if err := k.bankKeeper.SendCoins(ctx, payerAddr, refundAddr, fee.Total()); err != nil {
+		return errorsmod.Wrap(err, "failed to send relayer incentive to refund address")
+	}
+
+	packetID := channeltypes.NewPacketID(metadata.Port, metadata.Channel, sequence)
+	packetFee := feetypes.NewPacketFee(fee, incentive.RefundAddress, nil)
+	if _, err := k.feeKeeper.PayPacketFeeAsync(ctx, feetypes.NewMsgPayPacketFeeAsync(packetID, packetFee)); err != nil {
+		return errorsmod.Wrap(err, "failed to escrow relayer incentive")
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// isSplitForward returns true if the inbound packet of the completed in-flight packet was split across
+// several forwards, i.e. other forwards of it are still in flight or have already completed.
+func (k *Keeper) isSplitForward(ctx sdk.Context, inFlightPacket *types.InFlightPacket) bool {
+	if len(k.GetInFlightPacketsByInboundPacket(ctx, inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)) > 0 {
+		return true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.SplitForwardResultPrefixKey(inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// resolveSplitForward records the outcome of one forward of a split inbound packet. Once all forwards have
+// completed, the inbound packet is acknowledged:
+//   - if all forwards succeeded, with the acknowledgement of the last one.
+//   - if all forwards failed, with an error acknowledgement after returning the funds of each forward,
+//     refunding the whole packet on the source chain.
+//   - otherwise, with a result acknowledgement, as the source chain cannot refund part of a packet. The funds of
+//     each failed forward are sent back to the original sender in a refund packet, or if the packet is
+//     nonrefundable or recovered funds are claimable, moved to an account the original sender can recover them
+//     from on this chain.
+func (k *Keeper) resolveSplitForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	k.setSplitForwardResult(ctx, inFlightPacket, types.SplitForwardResult{
+		ChannelId: packet.SourceChannel,
+		PortId:    packet.SourcePort,
+		Sequence:  packet.Sequence,
+		Denom:     data.Denom,
+		Amount:    data.Amount,
+		Success:   ack.Success(),
+		Error:     ack.GetError(),
+
+		RateLimitCharge: inFlightPacket.RateLimitCharge,
+	})
+
+	if len(k.GetInFlightPacketsByInboundPacket(ctx, inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)) > 0 {
+		// wait for the remaining forwards to complete.
+		return nil
+	}
+
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	results := k.getAndClearSplitForwardResults(ctx, inFlightPacket)
+
+	var failed []types.SplitForwardResult
+	for _, result := range results {
+		if !result.Success {
+			failed = append(failed, result)
+		}
+	}
+
+	if len(failed) == 0 {
+		return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+	}
+
+	refund := len(failed) == len(results) && !inFlightPacket.Nonrefundable
+	sendRefunds := !refund && !inFlightPacket.Nonrefundable && !k.GetParams(ctx).ClaimableRecovery
+	refunded := 0
+	for _, result := range failed {
+		resultPacket := channeltypes.Packet{
+			Sequence:      result.Sequence,
+			SourcePort:    result.PortId,
+			SourceChannel: result.ChannelId,
+		}
+		resultData := transfertypes.FungibleTokenPacketData{
+			Denom:  result.Denom,
+			Amount: result.Amount,
+		}
+
+		switch {
+		case refund:
+			err = k.refundForwardedFunds(ctx, resultPacket, resultData, inFlightPacket, result.RateLimitCharge, result.Error)
+		case sendRefunds:
+			var sent bool
+			sent, err = k.sendRefundPacket(ctx, resultPacket, resultData, inFlightPacket, result.Error)
+			if sent {
+				refunded++
+			}
+		default:
+			err = k.recoverForwardedFunds(ctx, resultPacket, resultData, inFlightPacket, result.Error)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if refund {
+		return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+	}
+
+	ackResult := fmt.Sprintf("packet forward failed for %d of %d splits, their funds were moved to a recoverable account", len(failed), len(results))
+	if refunded == len(failed) {
+		ackResult = fmt.Sprintf("packet forward failed for %d of %d splits, their funds were refunded to the original sender", len(failed), len(results))
+	}
+	return k.writeInboundAcknowledgement(ctx, chanCap, inFlightPacket, channeltypes.NewResultAcknowledgement([]byte(ackResult)))
+}
+
+// sendRefundPacket moves the funds of a failed forwarded packet to the recovery address and sends them from there
+// back to the original sender on the inbound channel, in a packet that times out after the refund timeout. If the
+// refund packet fails, its funds are returned to the recovery address. Returns false if the refund packet could
+// not be sent, in which case the funds are left at the recovery address.
+func (k *Keeper) sendRefundPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ackErr string,
+) (bool, error) {
+	recoveryAddress, err := k.recoveryAddressResolver.ResolveRecoveryAddress(ctx, inFlightPacket)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user recoverable account: %w", err)
+	}
+
+	token, err := k.moveForwardedFunds(ctx, packet, data, recoveryAddress)
+	if err != nil {
+		return false, err
+	}
+
+	timeout := k.GetTimeouts(k.GetParams(ctx)).RefundTimeout
+	msgTransfer := transfertypes.NewMsgTransfer(
+		inFlightPacket.RefundPortId,
+		inFlightPacket.RefundChannelId,
+		token,
+		recoveryAddress.String(),
+		inFlightPacket.OriginalSenderAddress,
+		DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
+		"",
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msgTransfer)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware error sending refund packet",
+			"port", inFlightPacket.RefundPortId, "channel", inFlightPacket.RefundChannelId,
+			"sender", recoveryAddress, "receiver", inFlightPacket.OriginalSenderAddress,
+			"amount", token.Amount.String(), "denom", token.Denom,
+			"error", err,
+		)
+		return false, emitForwardRecovered(ctx, packet, data, inFlightPacket, recoveryAddress, ackErr, 0)
+	}
+	writeCache()
+
+	return true, ctx.EventManager().EmitTypedEvent(&types.EventForwardRefunded{
+		InboundPortId:     inFlightPacket.RefundPortId,
+		InboundChannelId:  inFlightPacket.RefundChannelId,
+		InboundSequence:   inFlightPacket.RefundSequence,
+		OutboundPortId:    packet.SourcePort,
+		OutboundChannelId: packet.SourceChannel,
+		OutboundSequence:  packet.Sequence,
+		Denom:             data.Denom,
+		Amount:            data.Amount,
+		Error:             ackErr,
+		RefundSequence:    res.Sequence,
+	})
+}
+
+func (k *Keeper) setSplitForwardResult(ctx sdk.Context, inFlightPacket *types.InFlightPacket, result types.SplitForwardResult) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SplitForwardResultKey(
+		inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence,
+		result.ChannelId, result.PortId, result.Sequence,
+	)
+	store.Set(key, k.cdc.MustMarshal(&result))
+}
+
+// getAndClearSplitForwardResults returns and removes the outcomes of the forwards of the inbound packet of
+// the in-flight packet.
+func (k *Keeper) getAndClearSplitForwardResults(ctx sdk.Context, inFlightPacket *types.InFlightPacket) []types.SplitForwardResult {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.SplitForwardResultPrefixKey(inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	var (
+		keys    [][]byte
+		results []types.SplitForwardResult
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.SplitForwardResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return results
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ValidateForward checks the forward metadata of a packet received with the given denom and amount against the
+// params and the registered forward handlers, returning the branches the amount is forwarded along. It is shared
+// by OnRecvPacket and SimulateForward, so a simulated packet is rejected wherever a received one would be.
+func (k *Keeper) ValidateForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	denom, amount string,
+	metadata *types.ForwardMetadata,
+) ([]types.ForwardBranch, error) {
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	amountInt, ok := sdkmath.NewIntFromString(amount)
+	if !ok {
+		return nil, fmt.Errorf("error parsing amount for forward: %s", amount)
+	}
+
+	branches, err := metadata.Branches(amountInt)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.ValidateForwardDepth(metadata); err != nil {
+		return nil, err
+	}
+
+	for _, branch := range branches {
+		if branch.Metadata.Handler != "" {
+			if _, found := k.GetForwardHandler(branch.Metadata.Handler); !found {
+				return nil, errorsmod.Wrap(types.ErrUnknownForwardHandler, branch.Metadata.Handler)
+			}
+			continue
+		}
+		if !params.IsForwardRouteAllowed(packet.DestinationChannel, branch.Metadata.Channel) {
+			return nil, errorsmod.Wrapf(types.ErrForwardRouteNotAllowed, "%s -> %s", packet.DestinationChannel, branch.Metadata.Channel)
+		}
+		if params.LoopDetection == types.LoopDetectionStrict {
+			if err := k.DetectForwardLoop(ctx, packet, denom, branch.Metadata); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return branches, nil
+}
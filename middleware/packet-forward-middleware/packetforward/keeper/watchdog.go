@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// CheckInboundTimeouts emits an EventForwardInboundTimeoutNear for every in-flight packet whose inbound packet has
+// come within the windows of the timeout watchdog param of its timeout timestamp or height, if the param is set.
+// Each in-flight packet is alerted of once: the watchdog only visits the inbound timeouts past those it reached
+// in previous blocks, including ones that already passed while it was disabled. Packets forwarded with an inbound
+// timeout the watchdog already reached are alerted of by alertReachedInboundTimeout instead.
+func (k *Keeper) CheckInboundTimeouts(ctx sdk.Context) error {
+	watchdog := k.GetParams(ctx).TimeoutWatchdog
+	if watchdog == nil {
+		return nil
+	}
+
+	timestamp := uint64(ctx.BlockTime().Add(watchdog.Window).UnixNano())
+	err := k.alertInboundTimeouts(ctx, k.inFlightPackets.Indexes.TimeoutTimestamp, k.timeoutWatchdogTimestamp, timestamp,
+		func(types.InFlightPacket) bool { return true },
+	)
+	if err != nil {
+		return err
+	}
+
+	// the height index only holds the revision height, so packets timing out in another revision are skipped.
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return k.alertInboundTimeouts(ctx, k.inFlightPackets.Indexes.TimeoutHeight, k.timeoutWatchdogHeight, selfHeight.RevisionHeight+watchdog.HeightWindow,
+		func(inFlightPacket types.InFlightPacket) bool {
+			height, err := clienttypes.ParseHeight(inFlightPacket.PacketTimeoutHeight)
+			return err == nil && height.RevisionNumber == selfHeight.RevisionNumber
+		},
+	)
+}
+
+// alertInboundTimeouts emits an EventForwardInboundTimeoutNear for every in-flight packet the filter accepts whose
+// inbound timeout in the index is past the cursor and at most until, then advances the cursor to until.
+func (k *Keeper) alertInboundTimeouts(
+	ctx sdk.Context,
+	index *indexes.Multi[uint64, types.PacketKey, types.InFlightPacket],
+	cursor collections.Item[uint64],
+	until uint64,
+	filter func(inFlightPacket types.InFlightPacket) bool,
+) error {
+	from, err := cursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if until <= from {
+		return nil
+	}
+
+	itr, err := index.Iterate(ctx, inboundTimeoutRange{from: from, until: until})
+	if err != nil {
+		return err
+	}
+	keys, err := itr.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		inFlightPacket, found := k.GetInFlightPacket(ctx, key.K1(), key.K2(), key.K3())
+		if !found || !filter(inFlightPacket) {
+			continue
+		}
+
+		if err := k.emitInboundTimeoutNear(ctx, key.K1(), key.K2(), key.K3(), inFlightPacket); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Set(ctx, until)
+}
+
+// alertReachedInboundTimeout emits an EventForwardInboundTimeoutNear for an in-flight packet that was just stored if
+// the timeout watchdog param is set and already reached the inbound timeout timestamp or height of the packet in a
+// previous block, as CheckInboundTimeouts only visits inbound timeouts past those.
+func (k *Keeper) alertReachedInboundTimeout(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+	inFlightPacket types.InFlightPacket,
+) error {
+	if k.GetParams(ctx).TimeoutWatchdog == nil {
+		return nil
+	}
+
+	reached, err := inboundTimeoutReached(ctx, k.timeoutWatchdogTimestamp, inFlightPacket.PacketTimeoutTimestamp)
+	if err != nil {
+		return err
+	}
+
+	if !reached {
+		height, err := clienttypes.ParseHeight(inFlightPacket.PacketTimeoutHeight)
+		if err == nil && height.RevisionNumber == clienttypes.GetSelfHeight(ctx).RevisionNumber {
+			reached, err = inboundTimeoutReached(ctx, k.timeoutWatchdogHeight, height.RevisionHeight)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !reached {
+		return nil
+	}
+	return k.emitInboundTimeoutNear(ctx, channel, port, sequence, inFlightPacket)
+}
+
+// inboundTimeoutReached returns whether the cursor of the timeout watchdog is at or past a set inbound timeout.
+func inboundTimeoutReached(ctx sdk.Context, cursor collections.Item[uint64], timeout uint64) (bool, error) {
+	if timeout == 0 {
+		return false, nil
+	}
+	reached, err := cursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return timeout <= reached, nil
+}
+
+// emitInboundTimeoutNear logs and emits an EventForwardInboundTimeoutNear for the in-flight packet forwarded with the
+// given channel, port and sequence.
+func (k *Keeper) emitInboundTimeoutNear(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+	inFlightPacket types.InFlightPacket,
+) error {
+	k.Logger(ctx).Info("packetForwardMiddleware inbound packet of in-flight packet nearing timeout",
+		"channel", channel, "port", port, "sequence", sequence,
+		"inbound-channel", inFlightPacket.RefundChannelId, "inbound-port", inFlightPacket.RefundPortId,
+		"inbound-sequence", inFlightPacket.RefundSequence,
+		"timeout-height", inFlightPacket.PacketTimeoutHeight, "timeout-timestamp", inFlightPacket.PacketTimeoutTimestamp,
+	)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardInboundTimeoutNear{
+		InboundPortId:           inFlightPacket.RefundPortId,
+		InboundChannelId:        inFlightPacket.RefundChannelId,
+		InboundSequence:         inFlightPacket.RefundSequence,
+		OutboundPortId:          port,
+		OutboundChannelId:       channel,
+		OutboundSequence:        sequence,
+		InboundTimeoutHeight:    inFlightPacket.PacketTimeoutHeight,
+		InboundTimeoutTimestamp: inFlightPacket.PacketTimeoutTimestamp,
+	})
+}
+
+// inboundTimeoutRange ranges over the entries of an inbound timeout index with a timeout past from and at most until.
+type inboundTimeoutRange struct {
+	from, until uint64
+}
+
+// RangeValues implements collections.Ranger.
+func (r inboundTimeoutRange) RangeValues() (
+	start, end *collections.RangeKey[collections.Pair[uint64, types.PacketKey]],
+	order collections.Order,
+	err error,
+) {
+	return collections.RangeKeyPrefixEnd(collections.PairPrefix[uint64, types.PacketKey](r.from)),
+		collections.RangeKeyPrefixEnd(collections.PairPrefix[uint64, types.PacketKey](r.until)),
+		collections.OrderAscending,
+		nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// alertedSequences returns the outbound sequences of the in-flight packets the timeout watchdog alerted of.
+func alertedSequences(t *testing.T, ctx sdk.Context) []uint64 {
+	t.Helper()
+
+	var sequences []uint64
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if alert, ok := msg.(*types.EventForwardInboundTimeoutNear); ok {
+			sequences = append(sequences, alert.OutboundSequence)
+		}
+	}
+	return sequences
+}
+
+func TestCheckInboundTimeouts(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := setup.Initializer.Ctx.WithBlockTime(blockTime).WithBlockHeight(100)
+	k := setup.Keepers.PacketForwardKeeper
+
+	timeoutAt := func(timestamp time.Time, height string) types.InFlightPacket {
+		packet := inFlightPacket("channel-1", "cosmos1alice", false)
+		if !timestamp.IsZero() {
+			packet.PacketTimeoutTimestamp = uint64(timestamp.UnixNano())
+		}
+		packet.PacketTimeoutHeight = height
+		return packet
+	}
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 1, timeoutAt(blockTime.Add(5*time.Minute), "0-0"))
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 2, timeoutAt(blockTime.Add(20*time.Minute), "0-0"))
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 3, timeoutAt(time.Time{}, "0-103"))
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 4, timeoutAt(time.Time{}, "1-103"))
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 5, timeoutAt(blockTime.Add(-time.Minute), "0-0"))
+
+	// the watchdog is disabled by default.
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Empty(t, alertedSequences(t, ctx))
+
+	params := types.DefaultParams()
+	params.TimeoutWatchdog = &types.TimeoutWatchdog{Window: 10 * time.Minute, HeightWindow: 5}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// packets within the windows are alerted of, including those already past their timeout, while packets
+	// timing out in another revision are skipped.
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Equal(t, []uint64{5, 1, 3}, alertedSequences(t, ctx))
+
+	// each packet is alerted of once.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Empty(t, alertedSequences(t, ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(blockTime.Add(15 * time.Minute))
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Equal(t, []uint64{2}, alertedSequences(t, ctx))
+
+	// resolved packets are no longer alerted of.
+	k.SetInFlightPacket(ctx, "channel-0", "transfer", 6, timeoutAt(blockTime.Add(30*time.Minute), "0-0"))
+	require.NotNil(t, k.GetAndClearInFlightPacket(ctx, "channel-0", "transfer", 6))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(blockTime.Add(30 * time.Minute))
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Empty(t, alertedSequences(t, ctx))
+}
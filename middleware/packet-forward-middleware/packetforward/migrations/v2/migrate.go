@@ -47,7 +47,7 @@ func validate(store storetypes.KVStore, cdc codec.BinaryCodec, currParams types.
 	}
 
 	if !currParams.FeePercentage.Equal(res.FeePercentage) {
-		return fmt.Errorf("expected %s but got %s", currParams, res)
+		return fmt.Errorf("expected %v but got %v", currParams, res)
 	}
 
 	return nil
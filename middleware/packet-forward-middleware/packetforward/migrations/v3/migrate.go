@@ -0,0 +1,89 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/packetforward module state from the consensus version 2 to
+// version 3. Specifically, it moves the in-flight packets, which were stored under their
+// raw channel/port/sequence keys in the same keyspace as the params, into the in-flight
+// packets collection, which indexes them by inbound packet and by the timeout timestamp
+// and height of their inbound packet. It also sets the params added since version 2 to
+// their upgrade values: the default forward depth and memo size limits, and loop
+// detection left at LOOP_DETECTION_ALLOW so that existing routes keep working.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	inFlightPackets *types.InFlightPackets,
+) error {
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if isLegacyInFlightPacketKey(iterator.Key()) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		channel, port, sequence, err := types.ParseRefundPacketKey(key)
+		if err != nil {
+			return fmt.Errorf("failed to migrate in-flight packet: %w", err)
+		}
+
+		inFlightPacket, err := inFlightPackets.ValueCodec().Decode(store.Get(key))
+		if err != nil {
+			return fmt.Errorf("failed to migrate in-flight packet %s: %w", key, err)
+		}
+
+		if err := inFlightPackets.Set(ctx, collections.Join3(channel, port, sequence), inFlightPacket); err != nil {
+			return err
+		}
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// migrateParams sets the forward depth and memo size limits and the loop detection of the stored params,
+// which version 2 did not have.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("expected params at key %s but not found", types.ParamsKey)
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.MaxForwardDepth = types.DefaultMaxForwardDepth
+	params.MaxMemoSize = types.DefaultMaxMemoSize
+	params.LoopDetection = types.LoopDetectionAllow
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+// isLegacyInFlightPacketKey returns true if the key is the raw key of an in-flight packet rather than one of
+// the module's prefixed keys, which all start with a non-printable byte.
+func isLegacyInFlightPacketKey(key []byte) bool {
+	return len(key) > 0 && key[0] >= 0x20
+}
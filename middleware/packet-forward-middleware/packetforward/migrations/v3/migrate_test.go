@@ -0,0 +1,97 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	v3 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/migrations/v3"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestMigrate validates the in-flight packets move from their raw keys into the in-flight packets collection
+// without loss, indexed by inbound packet and inbound timeout, and that the params added since version 2 are set.
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	inFlightPackets := types.NewInFlightPackets(sb, cdc)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// the params and in-flight packets as stored by consensus version 2.
+	params := types.NewParams(sdkmath.LegacyNewDecWithPrec(1, 2))
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	// two forwards split from the same inbound packet and one forward of another inbound packet.
+	entries := []types.InFlightPacketEntry{
+		{ChannelId: "channel-0", PortId: "transfer", Sequence: 3, InFlightPacket: types.InFlightPacket{
+			OriginalSenderAddress: "cosmos1alice", RefundChannelId: "channel-1", RefundPortId: "transfer", RefundSequence: 7,
+			PacketData: []byte(`{"amount":"100"}`), RetriesRemaining: 2, Nonrefundable: true,
+			PacketTimeoutHeight: "0-0", PacketTimeoutTimestamp: 1_000,
+		}},
+		{ChannelId: "channel-3", PortId: "transfer", Sequence: 1 << 40, InFlightPacket: types.InFlightPacket{
+			OriginalSenderAddress: "cosmos1alice", RefundChannelId: "channel-1", RefundPortId: "transfer", RefundSequence: 7,
+			PacketTimeoutHeight: "0-0", PacketTimeoutTimestamp: 1_000,
+		}},
+		{ChannelId: "channel-0", PortId: "custom", Sequence: 10, InFlightPacket: types.InFlightPacket{
+			OriginalSenderAddress: "cosmos1bob", RefundChannelId: "channel-4", RefundPortId: "transfer", RefundSequence: 2,
+			PacketTimeoutHeight: "1-50",
+		}},
+	}
+	for _, entry := range entries {
+		store.Set(types.RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence), cdc.MustMarshal(&entry.InFlightPacket))
+	}
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc, inFlightPackets))
+
+	for _, entry := range entries {
+		require.False(t, store.Has(types.RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence)))
+
+		res, err := inFlightPackets.Get(ctx, collections.Join3(entry.ChannelId, entry.PortId, entry.Sequence))
+		require.NoError(t, err)
+		require.Equal(t, entry.InFlightPacket, res)
+	}
+
+	itr, err := inFlightPackets.Indexes.Inbound.MatchExact(ctx, collections.Join3("channel-1", "transfer", uint64(7)))
+	require.NoError(t, err)
+	keys, err := itr.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketKey{
+		collections.Join3("channel-0", "transfer", uint64(3)),
+		collections.Join3("channel-3", "transfer", uint64(1<<40)),
+	}, keys)
+
+	timestampItr, err := inFlightPackets.Indexes.TimeoutTimestamp.MatchExact(ctx, 1_000)
+	require.NoError(t, err)
+	keys, err = timestampItr.PrimaryKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	heightItr, err := inFlightPackets.Indexes.TimeoutHeight.MatchExact(ctx, 50)
+	require.NoError(t, err)
+	keys, err = heightItr.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketKey{collections.Join3("channel-0", "custom", uint64(10))}, keys)
+
+	var migrated types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &migrated))
+	require.True(t, params.FeePercentage.Equal(migrated.FeePercentage))
+	require.Equal(t, uint32(types.DefaultMaxForwardDepth), migrated.MaxForwardDepth)
+	require.Equal(t, uint32(types.DefaultMaxMemoSize), migrated.MaxMemoSize)
+	require.Equal(t, types.LoopDetectionAllow, migrated.LoopDetection)
+}
@@ -3,11 +3,16 @@ package packetforward_test
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/ics721"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/test/mock"
 	"github.com/iancoleman/orderedmap"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -15,7 +20,12 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
@@ -112,6 +122,21 @@ func transferPacket256(t *testing.T, sender string, receiver string, metadata an
 	}
 }
 
+// requireTypedEvent asserts that the expected typed event was emitted on the context's event manager.
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		require.Equal(t, expected, msg)
+		return
+	}
+	t.Fatalf("event %s not emitted", proto.MessageName(expected))
+}
+
 func TestOnRecvPacket_EmptyPacket(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -296,12 +321,200 @@ func TestOnRecvPacket_ForwardNoFee(t *testing.T) {
 	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
 	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
 	require.Nil(t, ack)
+	requireTypedEvent(t, ctx, &types.EventForwardInitiated{
+		InboundPortId:     testDestinationPort,
+		InboundChannelId:  testDestinationChannel,
+		OutboundPortId:    port,
+		OutboundChannelId: channel,
+		Receiver:          destAddr,
+		Denom:             denom,
+		Amount:            testAmount,
+		Fee:               "0",
+	})
 
 	// ack returned from chain C
 	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr)
 	require.NoError(t, err)
 }
 
+func TestOnRecvPacket_InvalidForwardMetadata(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Port:    port,
+		Channel: channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+	// events of an errored OnRecvPacket are discarded, so the acknowledgement carries the reason.
+	errAck := ack.(channeltypes.Acknowledgement)
+	require.Equal(t,
+		"packet-forward-middleware error: failed to validate metadata. receiver cannot be empty",
+		errAck.GetError(),
+	)
+}
+
+func TestOnRecvPacket_InvalidRecoverAddress(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver:       destAddr,
+		Port:           port,
+		Channel:        channel,
+		RecoverAddress: "0x6ab3a38c6a9b7d5cc4c1cd4b0e1d1e2a3f5a9d7c",
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	// the packet is refunded on the source chain instead of failing once the forward is acknowledged.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+	errAck := ack.(channeltypes.Acknowledgement)
+	require.Contains(t, errAck.GetError(), "invalid recover address")
+}
+func TestOnRecvPacket_ForwardRouteDenied(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	params := types.DefaultParams()
+	params.DeniedRoutes = []types.ForwardRoute{{InboundChannelId: testDestinationChannel, OutboundChannelId: channel}}
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	// no funds are received or forwarded for a denied route.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Equal(t, "packet-forward-middleware error: channel-11 -> channel-0: forward route not allowed", expectedAck.GetError())
+}
+
+func TestOnRecvPacket_MemoTooLarge(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	params := types.DefaultParams()
+	params.MaxMemoSize = 256
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	senderAccAddr := test.AccAddress()
+	memo, err := types.NewMemoBuilder(types.Hop{Receiver: destAddr, Port: port, Channel: channel}).
+		WithPayload([]byte(fmt.Sprintf(`{"wasm":{"msg":"%s"}}`, strings.Repeat("a", 256)))).
+		Build()
+	require.NoError(t, err)
+	packetOrig := transferPacket(t, senderAddr, hostAddr, memo)
+
+	// the memo is rejected before the forward metadata is parsed, no funds are received or forwarded.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Equal(t, fmt.Sprintf("packet-forward-middleware error: memo of %d bytes exceeds the maximum of 256 bytes: memo too large", len(memo)), expectedAck.GetError())
+
+	// the limit also applies to forward metadata under an escaped key, which decodes to the same key.
+	escapedMemo := fmt.Sprintf(`{"\u0066orward":{"receiver":"%s","port":"%s","channel":"%s"},"pad":"%s"}`,
+		destAddr, port, channel, strings.Repeat("a", 256))
+	ack = forwardMiddleware.OnRecvPacket(ctx, transferPacket(t, senderAddr, hostAddr, escapedMemo), senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck = &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Contains(t, expectedAck.GetError(), types.ErrMemoTooLarge.Error())
+
+	// large memos that hold no forward metadata are passed to the underlying application.
+	otherMemo := fmt.Sprintf(`{"wasm":{"msg":"%s"}}`, strings.Repeat("a", 256))
+	packetOther := transferPacket(t, senderAddr, hostAddr, otherMemo)
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOther, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+
+	ack = forwardMiddleware.OnRecvPacket(ctx, packetOther, senderAccAddr)
+	require.True(t, ack.Success())
+}
+
+func TestOnRecvPacket_ForwardDepthExceeded(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	params := types.DefaultParams()
+	params.MaxForwardDepth = 3
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	builder := types.NewMemoBuilder()
+	for i := 0; i < 4; i++ {
+		builder.AddHop(types.Hop{Receiver: destAddr, Port: port, Channel: channel})
+	}
+	memo, err := builder.Build()
+	require.NoError(t, err)
+
+	senderAccAddr := test.AccAddress()
+	packetOrig := transferPacket(t, senderAddr, hostAddr, memo)
+
+	// no funds are received or forwarded for a path that is too deep.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Equal(t, "packet-forward-middleware error: forward exceeds the maximum depth of 3 hops: forward depth exceeded", expectedAck.GetError())
+}
+
+func TestOnRecvPacket_ForwardLoop(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     testDestinationPort,
+		Channel:  testDestinationChannel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	// no funds are received or forwarded for a forward back over the inbound channel.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Equal(t, "packet-forward-middleware error: forward back over inbound channel channel-11: forward loop detected", expectedAck.GetError())
+}
+
 func TestOnRecvPacket_ForwardAmountInt256(t *testing.T) {
 	var err error
 	ctl := gomock.NewController(t)
@@ -431,155 +644,451 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestOnRecvPacket_ForwardMultihopStringNext(t *testing.T) {
-	var err error
+func TestOnRecvPacket_ForwardWithRelayerIncentive(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
 	setup := test.NewTestSetup(t, ctl)
 	ctx := setup.Initializer.Ctx
-	cdc := setup.Initializer.Marshaler
 	forwardMiddleware := setup.ForwardMiddleware
 
+	// half of the 10% fee is escrowed for relayers on channel-0, split between the recv, ack and timeout fees.
+	refundAddr := test.AccAddress()
+	params := types.NewParams(sdkmath.LegacyNewDecWithPrec(10, 2))
+	params.RelayerIncentives = []types.RelayerIncentive{{
+		ChannelId:       channel,
+		RecvFeeShare:    sdkmath.LegacyNewDecWithPrec(3, 1),
+		AckFeeShare:     sdkmath.LegacyNewDecWithPrec(1, 1),
+		TimeoutFeeShare: sdkmath.LegacyNewDecWithPrec(1, 1),
+		RefundAddress:   refundAddr.String(),
+	}}
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
 	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
 	senderAccAddr := test.AccAddress()
-	senderAccAddr2 := test.AccAddress()
-	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
-	nextMetadata := &types.PacketMetadata{
-		Forward: &types.ForwardMetadata{
-			Receiver: destAddr,
-			Port:     port,
-			Channel:  channel2,
-		},
-	}
-	nextBz, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
-
-	metadata := &types.PacketMetadata{
-		Forward: &types.ForwardMetadata{
-			Receiver: hostAddr2,
-			Port:     port,
-			Channel:  channel,
-			Next:     types.NewJSONObject(false, nextBz, orderedmap.OrderedMap{}),
-		},
-	}
-
+	intermediateAccAddr := test.AccAddressFromBech32(t, intermediateAddr)
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
 	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
 	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
-	packet2 := transferPacket(t, intermediateAddr, hostAddr2, nextMetadata)
-	packet2ModifiedSender := transferPacket(t, intermediateAddr, intermediateAddr2, nil)
-	packetFwd := transferPacket(t, intermediateAddr2, destAddr, nil)
-
-	memo1, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
-
-	msgTransfer1 := transfertypes.NewMsgTransfer(
-		port,
-		channel,
-		testCoin,
-		intermediateAddr,
-		hostAddr2,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		string(memo1),
-	)
-
-	// no memo on final forward
-	msgTransfer2 := transfertypes.NewMsgTransfer(
-		port,
-		channel2,
-		testCoin,
-		intermediateAddr2,
-		destAddr,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		"",
-	)
 
-	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
-	successAck := cdc.MustMarshalJSON(&acknowledgement)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denom, amount)) }
+	relayerFee := feetypes.NewFee(coins(3), coins(1), coins(1))
 
-	// Expected mocks
 	gomock.InOrder(
 		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
-			Return(acknowledgement),
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
 
-		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
-			sdk.WrapSDKContext(ctx),
-			msgTransfer1,
-		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		setup.Mocks.FeeKeeperMock.EXPECT().IsFeeEnabled(ctx, port, channel).Return(true),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2ModifiedSender, senderAccAddr2).
-			Return(acknowledgement),
+		setup.Mocks.DistributionKeeperMock.EXPECT().FundCommunityPool(ctx, coins(5), intermediateAccAddr).Return(nil),
 
 		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
 			sdk.WrapSDKContext(ctx),
-			msgTransfer2,
-		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				sdk.NewInt64Coin(denom, 90),
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 3}, nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
-			Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, intermediateAccAddr, refundAddr, coins(5)).Return(nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
-			Return(nil),
+		setup.Mocks.FeeKeeperMock.EXPECT().PayPacketFeeAsync(ctx, feetypes.NewMsgPayPacketFeeAsync(
+			channeltypes.NewPacketID(port, channel, 3),
+			feetypes.NewPacketFee(relayerFee, refundAddr.String(), nil),
+		)).Return(&feetypes.MsgPayPacketFeeAsyncResponse{}, nil),
 	)
 
-	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
 	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
 	require.Nil(t, ack)
-
-	// chain C with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
-	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
-	require.Nil(t, ack)
-
-	// ack returned from chain D to chain C
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
-	require.NoError(t, err)
-
-	// ack returned from chain C to chain B
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
-	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventForwardInitiated{
+		InboundPortId:     testDestinationPort,
+		InboundChannelId:  testDestinationChannel,
+		OutboundPortId:    port,
+		OutboundChannelId: channel,
+		OutboundSequence:  3,
+		Receiver:          destAddr,
+		Denom:             denom,
+		Amount:            "90",
+		Fee:               "10",
+		RelayerIncentive:  coins(5).String(),
+	})
 }
 
-func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
-	var err error
+func TestOnRecvPacket_ForwardWithFeeOverride(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
 	setup := test.NewTestSetup(t, ctl)
 	ctx := setup.Initializer.Ctx
-	cdc := setup.Initializer.Marshaler
 	forwardMiddleware := setup.ForwardMiddleware
 
 	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
-	senderAccAddr := test.AccAddress()
-	senderAccAddr2 := test.AccAddress()
-	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
-	nextMetadata := &types.PacketMetadata{
-		Forward: &types.ForwardMetadata{
-			Receiver: destAddr,
-			Port:     port,
-			Channel:  channel2,
-		},
-	}
-	nextBz, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
 
-	nextJSONObject := new(types.JSONObject)
-	err = json.Unmarshal(nextBz, nextJSONObject)
-	require.NoError(t, err)
+	// 50% fee capped at 20 on the outbound channel, paid to an account instead of the community pool.
+	params := types.DefaultParams()
+	params.FeeOverrides = []types.FeeOverride{{
+		ChannelId:     channel,
+		Denom:         denom,
+		FeePercentage: sdkmath.LegacyNewDecWithPrec(50, 2),
+		MinFee:        sdkmath.ZeroInt(),
+		MaxFee:        sdkmath.NewInt(20),
+	}}
+	params.FeeRecipient = hostAddr2
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
 
-	metadata := &types.PacketMetadata{
-		Forward: &types.ForwardMetadata{
-			Receiver: hostAddr2,
-			Port:     port,
-			Channel:  channel,
-			Next:     nextJSONObject,
-		},
-	}
+	senderAccAddr := test.AccAddress()
+	intermediateAccAddr := test.AccAddressFromBech32(t, intermediateAddr)
+	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(80))
+	feeCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewInt(20))}
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
 	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
 	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
-	packet2 := transferPacket(t, intermediateAddr, hostAddr2, nextMetadata)
-	packet2ModifiedSender := transferPacket(t, intermediateAddr, intermediateAddr2, nil)
-	packetFwd := transferPacket(t, intermediateAddr2, destAddr, nil)
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			intermediateAccAddr,
+			test.AccAddressFromBech32(t, hostAddr2),
+			feeCoins,
+		).Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+}
+
+func TestOnRecvPacket_ForwardFeeToModuleAccount(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	params := types.NewParams(sdkmath.LegacyNewDecWithPrec(10, 2))
+	params.FeeRecipient = "fee_collector"
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	// the fee is sent to the module address, whether or not the module account exists.
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			test.AccAddressFromBech32(t, intermediateAddr),
+			authtypes.NewModuleAddress("fee_collector"),
+			sdk.Coins{sdk.NewCoin(denom, sdkmath.NewInt(10))},
+		).Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+}
+
+func TestOnRecvPacket_ForwardFeeConsumesAmount(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+
+	// the min fee is more than the packet amount.
+	params := types.DefaultParams()
+	params.FeeOverrides = []types.FeeOverride{{
+		ChannelId:     channel,
+		Denom:         denom,
+		FeePercentage: sdkmath.LegacyNewDecWithPrec(10, 2),
+		MinFee:        sdkmath.NewInt(150),
+		MaxFee:        sdkmath.ZeroInt(),
+	}}
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	// no fee is paid and nothing is forwarded.
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Contains(t, expectedAck.GetError(), types.ErrForwardFeeTooHigh.Error())
+}
+
+func TestOnRecvPacket_ForwardRateLimitExceeded(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{{
+		ChannelId: channel,
+		Denom:     denom,
+		MaxAmount: sdkmath.NewInt(150),
+		Period:    time.Hour,
+	}}
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// the second forward would exceed the rate limit.
+	ack = forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Contains(t, expectedAck.GetError(), "rate limit exceeded")
+
+	quota := setup.Keepers.PacketForwardKeeper.GetRateLimitQuota(ctx, params.RateLimits[0])
+	require.Equal(t, sdkmath.NewInt(100), quota.Used)
+	require.Equal(t, sdkmath.NewInt(50), quota.Remaining)
+}
+
+func TestOnRecvPacket_ForwardMultihopStringNext(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	senderAccAddr2 := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	nextMetadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel2,
+		},
+	}
+	nextBz, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	metadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: hostAddr2,
+			Port:     port,
+			Channel:  channel,
+			Next:     types.NewJSONObject(false, nextBz, orderedmap.OrderedMap{}),
+		},
+	}
+
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	packet2 := transferPacket(t, intermediateAddr, hostAddr2, nextMetadata)
+	packet2ModifiedSender := transferPacket(t, intermediateAddr, intermediateAddr2, nil)
+	packetFwd := transferPacket(t, intermediateAddr2, destAddr, nil)
+
+	memo1, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	msgTransfer1 := transfertypes.NewMsgTransfer(
+		port,
+		channel,
+		testCoin,
+		intermediateAddr,
+		hostAddr2,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		string(memo1),
+	)
+
+	// no memo on final forward
+	msgTransfer2 := transfertypes.NewMsgTransfer(
+		port,
+		channel2,
+		testCoin,
+		intermediateAddr2,
+		destAddr,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		"",
+	)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := cdc.MustMarshalJSON(&acknowledgement)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer1,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2ModifiedSender, senderAccAddr2).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer2,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
+			Return(nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
+			Return(nil),
+	)
+
+	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// chain C with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
+	require.Nil(t, ack)
+
+	// ack returned from chain D to chain C
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
+	require.NoError(t, err)
+
+	// ack returned from chain C to chain B
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	senderAccAddr2 := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	nextMetadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel2,
+		},
+	}
+	nextBz, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	nextJSONObject := new(types.JSONObject)
+	err = json.Unmarshal(nextBz, nextJSONObject)
+	require.NoError(t, err)
+
+	metadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: hostAddr2,
+			Port:     port,
+			Channel:  channel,
+			Next:     nextJSONObject,
+		},
+	}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	packet2 := transferPacket(t, intermediateAddr, hostAddr2, nextMetadata)
+	packet2ModifiedSender := transferPacket(t, intermediateAddr, intermediateAddr2, nil)
+	packetFwd := transferPacket(t, intermediateAddr2, destAddr, nil)
 
 	memo1, err := json.Marshal(nextMetadata)
 	require.NoError(t, err)
@@ -651,3 +1160,625 @@ func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
 	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
 	require.NoError(t, err)
 }
+
+func TestOnRecvPacket_ForwardInboundTimeoutReached(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := setup.Initializer.Ctx.WithBlockTime(blockTime)
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	params := types.DefaultParams()
+	params.TimeoutWatchdog = &types.TimeoutWatchdog{Window: 10 * time.Minute}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the watchdog reaches inbound timeouts up to ten minutes ahead before the packet is forwarded.
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetOrig.TimeoutTimestamp = uint64(blockTime.Add(5 * time.Minute).UnixNano())
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	packetModifiedSender.TimeoutTimestamp = packetOrig.TimeoutTimestamp
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 7}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// the packet is alerted of when it is forwarded, as the watchdog does not visit its timeout again.
+	requireTypedEvent(t, ctx, &types.EventForwardInboundTimeoutNear{
+		InboundPortId:           testDestinationPort,
+		InboundChannelId:        testDestinationChannel,
+		OutboundPortId:          port,
+		OutboundChannelId:       channel,
+		OutboundSequence:        7,
+		InboundTimeoutHeight:    "0-0",
+		InboundTimeoutTimestamp: packetOrig.TimeoutTimestamp,
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(blockTime.Add(time.Minute))
+	require.NoError(t, k.CheckInboundTimeouts(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestOnTimeoutPacket_Retry(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	senderAccAddr := test.AccAddress()
+	k.SetInFlightPacket(ctx, channel, port, 1, types.InFlightPacket{
+		OriginalSenderAddress: senderAddr,
+		RefundChannelId:       testDestinationChannel,
+		RefundPortId:          testDestinationPort,
+		RefundSequence:        3,
+		PacketSrcChannelId:    testSourceChannel,
+		PacketSrcPortId:       testSourcePort,
+		PacketTimeoutHeight:   "0-0",
+		Timeout:               uint64(10 * time.Minute),
+		RetriesRemaining:      1,
+	})
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		testAmount, intermediateAddr, destAddr, "",
+	)
+	timedOut := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    port,
+		SourceChannel: channel,
+		Data:          transfertypes.ModuleCdc.MustMarshalJSON(&data),
+	}
+
+	// the timed out packet is refunded on this chain and forwarded again under a new sequence.
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true),
+		setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, timedOut, senderAccAddr).Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 2}, nil),
+	)
+
+	require.NoError(t, forwardMiddleware.OnTimeoutPacket(ctx, timedOut, senderAccAddr))
+
+	// the timed out packet no longer counts as in flight for its inbound packet, only the retry does.
+	_, found := k.GetInFlightPacket(ctx, channel, port, 1)
+	require.False(t, found)
+	entries := k.GetInFlightPacketsByInboundPacket(ctx, testDestinationChannel, testDestinationPort, 3)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(2), entries[0].Sequence)
+	require.Equal(t, int32(0), entries[0].InFlightPacket.RetriesRemaining)
+}
+
+func TestOnTimeoutPacket_ClosedChannel(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	senderAccAddr := test.AccAddress()
+	k.SetInFlightPacket(ctx, channel, port, 1, types.InFlightPacket{
+		OriginalSenderAddress: senderAddr,
+		RefundChannelId:       testDestinationChannel,
+		RefundPortId:          testDestinationPort,
+		RefundSequence:        3,
+		PacketSrcChannelId:    testSourceChannel,
+		PacketSrcPortId:       testSourcePort,
+		PacketTimeoutHeight:   "0-0",
+		Timeout:               uint64(10 * time.Minute),
+		RetriesRemaining:      1,
+	})
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		testAmount, intermediateAddr, destAddr, "",
+	)
+	timedOut := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    port,
+		SourceChannel: channel,
+		Data:          transfertypes.ModuleCdc.MustMarshalJSON(&data),
+	}
+
+	// a packet timed out on close is not retried on the closed channel, its inbound packet is refunded instead.
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	tokens := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	chanCap := capabilitytypes.NewCapability(1)
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.CLOSED}, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(
+			ctx, transfertypes.GetEscrowAddress(port, channel), transfertypes.ModuleName, tokens,
+		).Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, tokens).Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, denom).Return(tokens[0]),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, gomock.Any()),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, _ *capabilitytypes.Capability, _ any, ack channeltypes.Acknowledgement) error {
+				require.False(t, ack.Success())
+				require.Contains(t, ack.GetError(), "outbound channel channel-0 closed")
+				return nil
+			}),
+	)
+
+	require.NoError(t, forwardMiddleware.OnTimeoutPacket(ctx, timedOut, senderAccAddr))
+
+	_, found := k.GetInFlightPacket(ctx, channel, port, 1)
+	require.False(t, found)
+}
+
+func TestOnRecvPacket_ForwardSplit(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Splits: []types.ForwardSplit{
+			{ForwardMetadata: types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel}, Percentage: "0.6"},
+			{ForwardMetadata: types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel2}, Percentage: "0.4"},
+		},
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(port, channel, sdk.NewCoin(denom, sdkmath.NewInt(60)), intermediateAddr, destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(port, channel2, sdk.NewCoin(denom, sdkmath.NewInt(40)), intermediateAddr, destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+	require.Len(t, k.GetInFlightPacketsByInboundPacket(ctx, testDestinationChannel, testDestinationPort, 0), 2)
+
+	forwardedPacket := func(channel string, amount string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(
+			transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+			amount, intermediateAddr, destAddr, "",
+		)
+		return channeltypes.Packet{
+			Sequence:      1,
+			SourcePort:    port,
+			SourceChannel: channel,
+			Data:          transfertypes.ModuleCdc.MustMarshalJSON(&data),
+		}
+	}
+
+	// the inbound packet is not acknowledged while a split is still in flight.
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket(channel, "60"), acknowledgement.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+
+	// the source chain cannot refund part of the packet as the other split was delivered, so the funds of the
+	// failed split are sent back to the original sender from the recovery address.
+	chanCap := capabilitytypes.NewCapability(1)
+	refunded := sdk.NewCoin(denom, sdkmath.NewInt(40))
+	refundTimeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultRefundTransferPacketTimeoutTimestamp.Nanoseconds())
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress(port, channel2),
+			test.AccAddressFromBech32(t, hostAddr),
+			sdk.NewCoins(refunded),
+		).Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, denom).
+			Return(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewCoin(denom, sdkmath.NewInt(60))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			gomock.Any(),
+			transfertypes.NewMsgTransfer(testDestinationPort, testDestinationChannel, refunded, hostAddr, senderAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, refundTimeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 7}, nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(
+			ctx, chanCap, gomock.Any(),
+			channeltypes.NewResultAcknowledgement([]byte("packet forward failed for 1 of 2 splits, their funds were refunded to the original sender")),
+		).Return(nil),
+	)
+
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive failed"))
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket(channel2, "40"), errorAck.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+
+	require.Empty(t, k.GetInFlightPacketsByInboundPacket(ctx, testDestinationChannel, testDestinationPort, 0))
+	requireTypedEvent(t, ctx, &types.EventForwardRefunded{
+		InboundPortId:     testDestinationPort,
+		InboundChannelId:  testDestinationChannel,
+		OutboundPortId:    port,
+		OutboundChannelId: channel2,
+		OutboundSequence:  1,
+		Denom:             transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		Amount:            "40",
+		Error:             errorAck.GetError(),
+		RefundSequence:    7,
+	})
+}
+
+func TestOnRecvPacket_ForwardTimeoutsParam(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	// the timeouts param replaces the timeouts the middleware was constructed with.
+	params := types.DefaultParams()
+	params.Timeouts = &types.Timeouts{RetriesOnTimeout: 2, ForwardTimeout: 5 * time.Minute, RefundTimeout: time.Hour}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Splits: []types.ForwardSplit{
+			{ForwardMetadata: types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel}, Percentage: "0.6"},
+			{ForwardMetadata: types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel2}, Percentage: "0.4"},
+		},
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(port, channel, sdk.NewCoin(denom, sdkmath.NewInt(60)), intermediateAddr, destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(port, channel2, sdk.NewCoin(denom, sdkmath.NewInt(40)), intermediateAddr, destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	for _, entry := range k.GetInFlightPacketsByInboundPacket(ctx, testDestinationChannel, testDestinationPort, 0) {
+		require.Equal(t, int32(2), entry.InFlightPacket.RetriesRemaining)
+		require.Equal(t, uint64(5*time.Minute), entry.InFlightPacket.Timeout)
+	}
+
+	forwardedPacket := func(channel string, amount string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(
+			transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+			amount, intermediateAddr, destAddr, "",
+		)
+		return channeltypes.Packet{
+			Sequence:      1,
+			SourcePort:    port,
+			SourceChannel: channel,
+			Data:          transfertypes.ModuleCdc.MustMarshalJSON(&data),
+		}
+	}
+
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket(channel, "60"), acknowledgement.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+
+	// the refund packet times out after the refund timeout. As it cannot be sent, the funds are left at the
+	// recovery address.
+	chanCap := capabilitytypes.NewCapability(1)
+	refunded := sdk.NewCoin(denom, sdkmath.NewInt(40))
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress(port, channel2),
+			test.AccAddressFromBech32(t, hostAddr),
+			sdk.NewCoins(refunded),
+		).Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, denom).
+			Return(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+		setup.Mocks.TransferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, sdk.NewCoin(denom, sdkmath.NewInt(60))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			gomock.Any(),
+			transfertypes.NewMsgTransfer(testDestinationPort, testDestinationChannel, refunded, hostAddr, senderAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), ""),
+		).Return(nil, fmt.Errorf("channel closed")),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(
+			ctx, chanCap, gomock.Any(),
+			channeltypes.NewResultAcknowledgement([]byte("packet forward failed for 1 of 2 splits, their funds were moved to a recoverable account")),
+		).Return(nil),
+	)
+
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive failed"))
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket(channel2, "40"), errorAck.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+
+	requireTypedEvent(t, ctx, &types.EventForwardRecovered{
+		InboundPortId:     testDestinationPort,
+		InboundChannelId:  testDestinationChannel,
+		OutboundPortId:    port,
+		OutboundChannelId: channel2,
+		OutboundSequence:  1,
+		Denom:             transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		Amount:            "40",
+		RecoveryAddress:   hostAddr,
+		Error:             errorAck.GetError(),
+	})
+}
+
+// testForwardHandler records the forwards delivered to it and fails them if err is set.
+type testForwardHandler struct {
+	err      error
+	forwards []sdk.Coin
+	memos    []string
+}
+
+func (h *testForwardHandler) HandleForward(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+	token sdk.Coin,
+	_ *types.ForwardMetadata,
+	memo string,
+) ([]byte, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+	h.forwards = append(h.forwards, token)
+	h.memos = append(h.memos, memo)
+	return []byte("handled"), nil
+}
+
+func TestOnRecvPacket_ForwardHandler(t *testing.T) {
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	next := orderedmap.New()
+	next.Set("swap", map[string]string{"out": "uosmo"})
+
+	testCases := []struct {
+		name       string
+		handler    string
+		handlerErr error
+		expErr     string
+	}{
+		{"handled", "swap", nil, ""},
+		{"handler fails", "swap", fmt.Errorf("no liquidity"), "no liquidity"},
+		{"unknown handler", "ica", nil, types.ErrUnknownForwardHandler.Error()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			setup := test.NewTestSetup(t, ctl)
+			ctx := setup.Initializer.Ctx
+			forwardMiddleware := setup.ForwardMiddleware
+
+			handler := &testForwardHandler{err: tc.handlerErr}
+			setup.Keepers.PacketForwardKeeper.SetForwardHandler("swap", handler)
+
+			senderAccAddr := test.AccAddress()
+			metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+				Receiver: destAddr,
+				Handler:  tc.handler,
+				Next:     types.NewJSONObject(true, nil, *next),
+			}}
+			packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+			packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+			if tc.handler == "swap" {
+				setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+					Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+			}
+
+			// the forward completes on this chain, so the inbound packet is acknowledged right away.
+			ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+			require.NotNil(t, ack)
+
+			if tc.expErr != "" {
+				require.False(t, ack.Success())
+				require.Contains(t, string(ack.Acknowledgement()), tc.expErr)
+				require.Empty(t, handler.forwards)
+				return
+			}
+
+			require.Equal(t, channeltypes.NewResultAcknowledgement([]byte("handled")), ack)
+			require.Equal(t, []sdk.Coin{sdk.NewCoin(denom, sdkmath.NewInt(100))}, handler.forwards)
+			require.Equal(t, []string{`{"swap":{"out":"uosmo"}}`}, handler.memos)
+			requireTypedEvent(t, ctx, &types.EventForwardHandled{
+				InboundPortId:    testDestinationPort,
+				InboundChannelId: testDestinationChannel,
+				Handler:          "swap",
+				Receiver:         destAddr,
+				Denom:            denom,
+				Amount:           testAmount,
+			})
+		})
+	}
+}
+
+func TestOnRecvPacket_ForwardSplitHandler(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	handler := &testForwardHandler{}
+	setup.Keepers.PacketForwardKeeper.SetForwardHandler("swap", handler)
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Splits: []types.ForwardSplit{
+			{ForwardMetadata: types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel}, Percentage: "0.6"},
+			{ForwardMetadata: types.ForwardMetadata{Handler: "swap"}, Percentage: "0.4"},
+		},
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetOrig.Sequence = 1
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	packetModifiedSender.Sequence = 1
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(port, channel, sdk.NewCoin(denom, sdkmath.NewInt(60)), intermediateAddr, destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, ""),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+	)
+
+	// the handled split completes right away, the inbound packet waits for the transferred split.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+	require.Equal(t, []sdk.Coin{sdk.NewCoin(denom, sdkmath.NewInt(40))}, handler.forwards)
+
+	// the pending split, including the result of the handled split, survives an export and import.
+	exported := setup.Keepers.PacketForwardKeeper.ExportGenesis(ctx)
+	require.Len(t, exported.SplitForwardResults, 1)
+	require.Equal(t, "swap", exported.SplitForwardResults[0].Result.Handler)
+	require.NoError(t, exported.Validate())
+
+	imported := test.NewTestSetup(t, ctl)
+	imported.Keepers.PacketForwardKeeper.InitGenesis(imported.Initializer.Ctx, *exported)
+	require.Equal(t, exported, imported.Keepers.PacketForwardKeeper.ExportGenesis(imported.Initializer.Ctx))
+
+	chanCap := capabilitytypes.NewCapability(1)
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), acknowledgement).Return(nil),
+	)
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		"60", intermediateAddr, destAddr, "",
+	)
+	forwardedPacket := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    port,
+		SourceChannel: channel,
+		Data:          transfertypes.ModuleCdc.MustMarshalJSON(&data),
+	}
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket, acknowledgement.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnRecvPacket_ForwardNFT(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	k := setup.Keepers.PacketForwardKeeper
+
+	nftTransferKeeperMock := mock.NewMockNFTTransferKeeper(ctl)
+	nftKeeperMock := mock.NewMockNFTKeeper(ctl)
+	k.SetPacketDataAdapter("nft-transfer", ics721.NewAdapter(nftTransferKeeperMock, nftKeeperMock))
+
+	nftPacket := func(classID, sender, receiver string, metadata any) channeltypes.Packet {
+		data := ics721.NonFungibleTokenPacketData{
+			ClassId:  classID,
+			TokenIds: []string{"kitty-1"},
+			Sender:   sender,
+			Receiver: receiver,
+		}
+		if metadata != nil {
+			memo, err := json.Marshal(metadata)
+			require.NoError(t, err)
+			data.Memo = string(memo)
+		}
+		bz, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		return channeltypes.Packet{
+			SourcePort:         "nft-transfer",
+			SourceChannel:      testSourceChannel,
+			DestinationPort:    "nft-transfer",
+			DestinationChannel: testDestinationChannel,
+			Data:               bz,
+		}
+	}
+
+	classID := makeIBCDenom("nft-transfer", testDestinationChannel, "kitties")
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{Receiver: destAddr, Port: "nft-transfer", Channel: channel}}
+	packetOrig := nftPacket("kitties", senderAddr, hostAddr, metadata)
+	packetModifiedSender := nftPacket("kitties", senderAddr, intermediateAddr, nil)
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		nftTransferKeeperMock.EXPECT().SendTransfer(
+			ctx, "nft-transfer", channel, classID, []string{"kitty-1"},
+			test.AccAddressFromBech32(t, intermediateAddr), destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, "",
+		).Return(uint64(1), nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	_, found := k.GetInFlightPacket(ctx, channel, "nft-transfer", 1)
+	require.True(t, found)
+
+	// the forwarded vouchers are burned ahead of the error acknowledgement refunding the source chain.
+	chanCap := capabilitytypes.NewCapability(1)
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive failed"))
+	gomock.InOrder(
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, "nft-transfer", testDestinationChannel).
+			Return("nft-transfer", chanCap, nil),
+		nftKeeperMock.EXPECT().Burn(ctx, classID, "kitty-1").Return(nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), errorAck).Return(nil),
+	)
+
+	forwardedPacket := nftPacket("nft-transfer/"+testDestinationChannel+"/kitties", intermediateAddr, destAddr, nil)
+	forwardedPacket.Sequence = 1
+	forwardedPacket.SourceChannel = channel
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, forwardedPacket, errorAck.Acknowledgement(), senderAccAddr)
+	require.NoError(t, err)
+
+	_, found = k.GetInFlightPacket(ctx, channel, "nft-transfer", 1)
+	require.False(t, found)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ForwardablePacketData is the packet data of an application other than ICS-20 that can be forwarded
+// through a PacketDataAdapter.
+type ForwardablePacketData interface {
+	GetSender() string
+	GetReceiver() string
+	GetMemo() string
+}
+
+// PacketDataAdapter lets the middleware forward the packets of an application other than ICS-20, e.g.
+// ICS-721 non-fungible token transfers, with the same forward memo semantics. Adapters are registered
+// with the keeper for the port the application is bound to.
+type PacketDataAdapter interface {
+	// ParsePacketData parses the packet data of the application, returning an error if the data does
+	// not belong to it.
+	ParsePacketData(bz []byte) (ForwardablePacketData, error)
+
+	// ReceivePacketData returns the packet data with the receiver overridden and the memo cleared, which
+	// the underlying application receives the assets of an inbound packet with.
+	ReceivePacketData(data ForwardablePacketData, receiver string) ([]byte, error)
+
+	// ForwardPacket sends the assets received by sender from the inbound packet on to the next hop
+	// described by the metadata and returns the sequence of the forwarded packet.
+	ForwardPacket(
+		ctx sdk.Context,
+		inboundPacket channeltypes.Packet,
+		data ForwardablePacketData,
+		sender string,
+		metadata *ForwardMetadata,
+		memo string,
+		timeoutTimestamp uint64,
+	) (uint64, error)
+
+	// RefundPacket returns the assets of the inbound packet that were forwarded on the given port and
+	// channel to where they were received from, ahead of an error acknowledgement refunding them on the
+	// source chain.
+	RefundPacket(
+		ctx sdk.Context,
+		inboundPacket channeltypes.Packet,
+		data ForwardablePacketData,
+		port, channel string,
+	) error
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ParseBackoffStrategy parses a backoff strategy from its name in forward metadata,
+// e.g. "linear", or its enum name, e.g. "BACKOFF_STRATEGY_LINEAR".
+func ParseBackoffStrategy(name string) (BackoffStrategy, error) {
+	upper := strings.ToUpper(name)
+	if strategy, ok := BackoffStrategy_value[upper]; ok {
+		return BackoffStrategy(strategy), nil
+	}
+	if strategy, ok := BackoffStrategy_value["BACKOFF_STRATEGY_"+upper]; ok {
+		return BackoffStrategy(strategy), nil
+	}
+
+	return BackoffNone, fmt.Errorf("invalid backoff strategy %q", name)
+}
+
+// Validate asserts that the backoff strategy is known and the max timeout is not negative.
+func (b RetryBackoff) Validate() error {
+	if _, ok := BackoffStrategy_name[int32(b.Strategy)]; !ok {
+		return fmt.Errorf("invalid backoff strategy %d", b.Strategy)
+	}
+	if b.MaxTimeout < 0 {
+		return fmt.Errorf("max timeout cannot be negative, got %s", b.MaxTimeout)
+	}
+
+	return nil
+}
+
+// Timeout returns the timeout of the given retry of a forward with the given initial timeout.
+// The timeout grows by the initial timeout with each linear retry and doubles with each
+// exponential retry, until it reaches the max timeout. It never falls below the initial timeout.
+func (b RetryBackoff) Timeout(initial time.Duration, retry uint32) time.Duration {
+	timeout := initial
+	for i := uint32(0); i < retry; i++ {
+		if b.MaxTimeout > 0 && timeout >= b.MaxTimeout {
+			break
+		}
+
+		switch b.Strategy {
+		case BackoffLinear:
+			if timeout > math.MaxInt64-initial {
+				return math.MaxInt64
+			}
+			timeout += initial
+		case BackoffExponential:
+			if timeout > math.MaxInt64/2 {
+				return math.MaxInt64
+			}
+			timeout *= 2
+		default:
+			return timeout
+		}
+	}
+
+	if b.MaxTimeout > 0 && timeout > b.MaxTimeout {
+		return max(b.MaxTimeout, initial)
+	}
+
+	return timeout
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBackoffTimeout(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backoff  types.RetryBackoff
+		retry    uint32
+		expected time.Duration
+	}{
+		{"none", types.RetryBackoff{}, 3, 10 * time.Minute},
+		{"initial", types.RetryBackoff{Strategy: types.BackoffExponential}, 0, 10 * time.Minute},
+		{"linear", types.RetryBackoff{Strategy: types.BackoffLinear}, 3, 40 * time.Minute},
+		{"exponential", types.RetryBackoff{Strategy: types.BackoffExponential}, 3, 80 * time.Minute},
+		{"capped", types.RetryBackoff{Strategy: types.BackoffExponential, MaxTimeout: time.Hour}, 3, time.Hour},
+		{"cap below initial", types.RetryBackoff{Strategy: types.BackoffLinear, MaxTimeout: time.Minute}, 3, 10 * time.Minute},
+		{"saturated", types.RetryBackoff{Strategy: types.BackoffExponential}, 255, time.Duration(1<<63 - 1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.backoff.Timeout(10*time.Minute, tc.retry))
+		})
+	}
+}
+
+func TestRetryBackoffValidate(t *testing.T) {
+	require.NoError(t, types.RetryBackoff{Strategy: types.BackoffLinear, MaxTimeout: time.Hour}.Validate())
+	require.Error(t, types.RetryBackoff{Strategy: types.BackoffStrategy(3)}.Validate())
+	require.Error(t, types.RetryBackoff{MaxTimeout: -time.Second}.Validate())
+}
@@ -29,12 +29,22 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "packetforward/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "packetforward/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgResetRateLimit{}, "packetforward/MsgResetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgForceRefundInFlightPacket{}, "packetforward/MsgForceRefundInFlight")
+	legacy.RegisterAminoMsg(cdc, &MsgClearInFlightPacket{}, "packetforward/MsgClearInFlightPacket")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRecoveredFunds{}, "packetforward/MsgClaimRecoveredFunds")
+	legacy.RegisterAminoMsg(cdc, &MsgSetClaimDelegate{}, "packetforward/MsgSetClaimDelegate")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgResetRateLimit{},
+		&MsgForceRefundInFlightPacket{},
+		&MsgClearInFlightPacket{},
+		&MsgClaimRecoveredFunds{},
+		&MsgSetClaimDelegate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/packetforward module sentinel errors
+var (
+	ErrForwardRouteNotAllowed = errorsmod.Register(ModuleName, 2, "forward route not allowed")
+	ErrRateLimitExceeded      = errorsmod.Register(ModuleName, 3, "rate limit exceeded")
+	ErrMemoTooLarge           = errorsmod.Register(ModuleName, 4, "memo too large")
+	ErrForwardDepthExceeded   = errorsmod.Register(ModuleName, 5, "forward depth exceeded")
+	ErrForwardLoop            = errorsmod.Register(ModuleName, 6, "forward loop detected")
+	ErrUnknownForwardHandler  = errorsmod.Register(ModuleName, 7, "unknown forward handler")
+	ErrForwardFeeTooHigh      = errorsmod.Register(ModuleName, 8, "forward fee too high")
+)
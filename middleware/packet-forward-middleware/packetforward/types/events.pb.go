@@ -0,0 +1,4651 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: packetforward/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventForwardInitiated is emitted when an inbound packet is forwarded to the
+// next hop for the first time.
+type EventForwardInitiated struct {
+	// inbound_port_id is the port on this chain the inbound packet was received on.
+	InboundPortId string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	// inbound_channel_id is the channel on this chain the inbound packet was received on.
+	InboundChannelId string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	// inbound_sequence is the sequence of the inbound packet.
+	InboundSequence uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	// outbound_port_id is the port the packet was forwarded on.
+	OutboundPortId string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	// outbound_channel_id is the channel the packet was forwarded on.
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	// outbound_sequence is the sequence of the forwarded packet.
+	OutboundSequence uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+	// receiver is the receiver of the forwarded packet on the next hop.
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// denom is the denom of the forwarded token on this chain.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount forwarded, after the fee has been taken.
+	Amount string `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	// fee is the amount taken as a fee before forwarding.
+	Fee string `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	// relayer_incentive is the part of the fee escrowed as ICS-29 relayer fees
+	// for the forwarded packet.
+	RelayerIncentive string `protobuf:"bytes,11,opt,name=relayer_incentive,json=relayerIncentive,proto3" json:"relayer_incentive,omitempty"`
+}
+
+func (m *EventForwardInitiated) Reset()         { *m = EventForwardInitiated{} }
+func (m *EventForwardInitiated) String() string { return proto.CompactTextString(m) }
+func (*EventForwardInitiated) ProtoMessage()    {}
+func (*EventForwardInitiated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{0}
+}
+func (m *EventForwardInitiated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardInitiated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardInitiated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardInitiated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardInitiated.Merge(m, src)
+}
+func (m *EventForwardInitiated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardInitiated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardInitiated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardInitiated proto.InternalMessageInfo
+
+func (m *EventForwardInitiated) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardInitiated) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardInitiated) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventForwardInitiated) GetRelayerIncentive() string {
+	if m != nil {
+		return m.RelayerIncentive
+	}
+	return ""
+}
+
+// EventForwardHandled is emitted when an inbound packet is forwarded to a
+// forward handler registered by the app rather than to another chain.
+type EventForwardHandled struct {
+	// inbound_port_id is the port on this chain the inbound packet was received on.
+	InboundPortId string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	// inbound_channel_id is the channel on this chain the inbound packet was received on.
+	InboundChannelId string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	// inbound_sequence is the sequence of the inbound packet.
+	InboundSequence uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	// handler is the name of the forward handler the funds were delivered to.
+	Handler string `protobuf:"bytes,4,opt,name=handler,proto3" json:"handler,omitempty"`
+	// receiver is the receiver set in the forward metadata, if any.
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// denom is the denom of the forwarded token on this chain.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount delivered to the handler.
+	Amount string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventForwardHandled) Reset()         { *m = EventForwardHandled{} }
+func (m *EventForwardHandled) String() string { return proto.CompactTextString(m) }
+func (*EventForwardHandled) ProtoMessage()    {}
+func (*EventForwardHandled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{1}
+}
+func (m *EventForwardHandled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardHandled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardHandled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardHandled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardHandled.Merge(m, src)
+}
+func (m *EventForwardHandled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardHandled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardHandled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardHandled proto.InternalMessageInfo
+
+func (m *EventForwardHandled) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardHandled) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardHandled) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardHandled) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *EventForwardHandled) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *EventForwardHandled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventForwardHandled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventForwardRetried is emitted when a forwarded packet timed out and is
+// sent again.
+type EventForwardRetried struct {
+	InboundPortId    string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundChannelId string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundSequence  uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	// outbound_port_id is the port the packet was forwarded on again.
+	OutboundPortId string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	// outbound_channel_id is the channel the packet was forwarded on again.
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	// outbound_sequence is the sequence of the retried packet.
+	OutboundSequence uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+	Denom            string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount           string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee              string `protobuf:"bytes,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	// retries_remaining is the number of retries left after this one.
+	RetriesRemaining int32 `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+}
+
+func (m *EventForwardRetried) Reset()         { *m = EventForwardRetried{} }
+func (m *EventForwardRetried) String() string { return proto.CompactTextString(m) }
+func (*EventForwardRetried) ProtoMessage()    {}
+func (*EventForwardRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{2}
+}
+func (m *EventForwardRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardRetried.Merge(m, src)
+}
+func (m *EventForwardRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardRetried proto.InternalMessageInfo
+
+func (m *EventForwardRetried) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRetried) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRetried) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventForwardRetried) GetRetriesRemaining() int32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+// EventForwardRefunded is emitted when a forwarded packet failed and the
+// inbound packet is acknowledged with an error so the source chain refunds it,
+// or its funds are sent back to the original sender in a refund packet.
+type EventForwardRefunded struct {
+	InboundPortId     string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundChannelId  string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundSequence   uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	OutboundPortId    string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	OutboundSequence  uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+	Denom             string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount            string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// error is the error the forwarded packet failed with.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// refund_sequence is the sequence of the packet this chain sent to refund
+	// the funds to the original sender, if the source chain could not refund
+	// them, zero otherwise.
+	RefundSequence uint64 `protobuf:"varint,10,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+}
+
+func (m *EventForwardRefunded) Reset()         { *m = EventForwardRefunded{} }
+func (m *EventForwardRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventForwardRefunded) ProtoMessage()    {}
+func (*EventForwardRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{3}
+}
+func (m *EventForwardRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardRefunded.Merge(m, src)
+}
+func (m *EventForwardRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardRefunded proto.InternalMessageInfo
+
+func (m *EventForwardRefunded) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRefunded) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRefunded) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventForwardRefunded) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+// EventForwardRecovered is emitted when a nonrefundable forwarded packet
+// failed and its funds were moved to an account on this chain instead.
+type EventForwardRecovered struct {
+	InboundPortId     string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundChannelId  string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundSequence   uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	OutboundPortId    string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	OutboundSequence  uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+	Denom             string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount            string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// recovery_address is the account on this chain the funds were moved to.
+	RecoveryAddress string `protobuf:"bytes,9,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	Error           string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// claim_id is the claim holding the funds if they were moved to the claims
+	// escrow account, zero otherwise.
+	ClaimId uint64 `protobuf:"varint,11,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+}
+
+func (m *EventForwardRecovered) Reset()         { *m = EventForwardRecovered{} }
+func (m *EventForwardRecovered) String() string { return proto.CompactTextString(m) }
+func (*EventForwardRecovered) ProtoMessage()    {}
+func (*EventForwardRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{4}
+}
+func (m *EventForwardRecovered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardRecovered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardRecovered.Merge(m, src)
+}
+func (m *EventForwardRecovered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardRecovered proto.InternalMessageInfo
+
+func (m *EventForwardRecovered) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRecovered) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardRecovered) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetRecoveryAddress() string {
+	if m != nil {
+		return m.RecoveryAddress
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventForwardRecovered) GetClaimId() uint64 {
+	if m != nil {
+		return m.ClaimId
+	}
+	return 0
+}
+
+// EventForwardCleared is emitted when an in-flight packet is removed by the
+// authority without acknowledging its inbound packet.
+type EventForwardCleared struct {
+	InboundPortId     string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundChannelId  string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundSequence   uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	OutboundPortId    string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	OutboundSequence  uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+}
+
+func (m *EventForwardCleared) Reset()         { *m = EventForwardCleared{} }
+func (m *EventForwardCleared) String() string { return proto.CompactTextString(m) }
+func (*EventForwardCleared) ProtoMessage()    {}
+func (*EventForwardCleared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{5}
+}
+func (m *EventForwardCleared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardCleared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardCleared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardCleared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardCleared.Merge(m, src)
+}
+func (m *EventForwardCleared) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardCleared) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardCleared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardCleared proto.InternalMessageInfo
+
+func (m *EventForwardCleared) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardCleared) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardCleared) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardCleared) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardCleared) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardCleared) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+// EventForwardDeliveredAfterRefund is emitted when a forwarded packet the
+// authority force refunded is acknowledged successfully after all. Its funds
+// were delivered on the next chain as well as refunded, so the shortfall must be
+// resolved by the operators.
+type EventForwardDeliveredAfterRefund struct {
+	OutboundPortId    string `protobuf:"bytes,1,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	OutboundChannelId string `protobuf:"bytes,2,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	OutboundSequence  uint64 `protobuf:"varint,3,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+}
+
+func (m *EventForwardDeliveredAfterRefund) Reset()         { *m = EventForwardDeliveredAfterRefund{} }
+func (m *EventForwardDeliveredAfterRefund) String() string { return proto.CompactTextString(m) }
+func (*EventForwardDeliveredAfterRefund) ProtoMessage()    {}
+func (*EventForwardDeliveredAfterRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{6}
+}
+func (m *EventForwardDeliveredAfterRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardDeliveredAfterRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardDeliveredAfterRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardDeliveredAfterRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardDeliveredAfterRefund.Merge(m, src)
+}
+func (m *EventForwardDeliveredAfterRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardDeliveredAfterRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardDeliveredAfterRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardDeliveredAfterRefund proto.InternalMessageInfo
+
+func (m *EventForwardDeliveredAfterRefund) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardDeliveredAfterRefund) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardDeliveredAfterRefund) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+// EventForwardInboundTimeoutNear is emitted by the timeout watchdog when the
+// inbound packet of an in-flight packet comes within the alert window of its
+// timeout while the forward is still pending.
+type EventForwardInboundTimeoutNear struct {
+	InboundPortId     string `protobuf:"bytes,1,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundChannelId  string `protobuf:"bytes,2,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundSequence   uint64 `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	OutboundPortId    string `protobuf:"bytes,4,opt,name=outbound_port_id,json=outboundPortId,proto3" json:"outbound_port_id,omitempty"`
+	OutboundChannelId string `protobuf:"bytes,5,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+	OutboundSequence  uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+	// inbound_timeout_height is the timeout height of the inbound packet.
+	InboundTimeoutHeight string `protobuf:"bytes,7,opt,name=inbound_timeout_height,json=inboundTimeoutHeight,proto3" json:"inbound_timeout_height,omitempty"`
+	// inbound_timeout_timestamp is the timeout timestamp of the inbound packet
+	// in unix nanoseconds.
+	InboundTimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=inbound_timeout_timestamp,json=inboundTimeoutTimestamp,proto3" json:"inbound_timeout_timestamp,omitempty"`
+}
+
+func (m *EventForwardInboundTimeoutNear) Reset()         { *m = EventForwardInboundTimeoutNear{} }
+func (m *EventForwardInboundTimeoutNear) String() string { return proto.CompactTextString(m) }
+func (*EventForwardInboundTimeoutNear) ProtoMessage()    {}
+func (*EventForwardInboundTimeoutNear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{7}
+}
+func (m *EventForwardInboundTimeoutNear) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardInboundTimeoutNear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardInboundTimeoutNear.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardInboundTimeoutNear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardInboundTimeoutNear.Merge(m, src)
+}
+func (m *EventForwardInboundTimeoutNear) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardInboundTimeoutNear) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardInboundTimeoutNear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardInboundTimeoutNear proto.InternalMessageInfo
+
+func (m *EventForwardInboundTimeoutNear) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardInboundTimeoutNear) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardInboundTimeoutNear) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardInboundTimeoutNear) GetOutboundPortId() string {
+	if m != nil {
+		return m.OutboundPortId
+	}
+	return ""
+}
+
+func (m *EventForwardInboundTimeoutNear) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
+	}
+	return ""
+}
+
+func (m *EventForwardInboundTimeoutNear) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func (m *EventForwardInboundTimeoutNear) GetInboundTimeoutHeight() string {
+	if m != nil {
+		return m.InboundTimeoutHeight
+	}
+	return ""
+}
+
+func (m *EventForwardInboundTimeoutNear) GetInboundTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.InboundTimeoutTimestamp
+	}
+	return 0
+}
+
+// EventRecoveredFundsClaimed is emitted when the funds held for the failed
+// nonrefundable forwards of an original sender are claimed.
+type EventRecoveredFundsClaimed struct {
+	// original_sender_address is the sender the claims were held for.
+	OriginalSenderAddress string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	// claimer is the account the funds were sent to.
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// amount is the total amount claimed.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// claim_ids are the claims that were withdrawn.
+	ClaimIds []uint64 `protobuf:"varint,4,rep,packed,name=claim_ids,json=claimIds,proto3" json:"claim_ids,omitempty"`
+}
+
+func (m *EventRecoveredFundsClaimed) Reset()         { *m = EventRecoveredFundsClaimed{} }
+func (m *EventRecoveredFundsClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventRecoveredFundsClaimed) ProtoMessage()    {}
+func (*EventRecoveredFundsClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c19d8acd9cd7d747, []int{8}
+}
+func (m *EventRecoveredFundsClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecoveredFundsClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecoveredFundsClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecoveredFundsClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecoveredFundsClaimed.Merge(m, src)
+}
+func (m *EventRecoveredFundsClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecoveredFundsClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecoveredFundsClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecoveredFundsClaimed proto.InternalMessageInfo
+
+func (m *EventRecoveredFundsClaimed) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *EventRecoveredFundsClaimed) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *EventRecoveredFundsClaimed) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventRecoveredFundsClaimed) GetClaimIds() []uint64 {
+	if m != nil {
+		return m.ClaimIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventForwardInitiated)(nil), "packetforward.v1.EventForwardInitiated")
+	proto.RegisterType((*EventForwardHandled)(nil), "packetforward.v1.EventForwardHandled")
+	proto.RegisterType((*EventForwardRetried)(nil), "packetforward.v1.EventForwardRetried")
+	proto.RegisterType((*EventForwardRefunded)(nil), "packetforward.v1.EventForwardRefunded")
+	proto.RegisterType((*EventForwardRecovered)(nil), "packetforward.v1.EventForwardRecovered")
+	proto.RegisterType((*EventForwardCleared)(nil), "packetforward.v1.EventForwardCleared")
+	proto.RegisterType((*EventForwardDeliveredAfterRefund)(nil), "packetforward.v1.EventForwardDeliveredAfterRefund")
+	proto.RegisterType((*EventForwardInboundTimeoutNear)(nil), "packetforward.v1.EventForwardInboundTimeoutNear")
+	proto.RegisterType((*EventRecoveredFundsClaimed)(nil), "packetforward.v1.EventRecoveredFundsClaimed")
+}
+
+func init() { proto.RegisterFile("packetforward/v1/events.proto", fileDescriptor_c19d8acd9cd7d747) }
+
+var fileDescriptor_c19d8acd9cd7d747 = []byte{
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
+	0x18, 0x35, 0x4d, 0xfd, 0x3c, 0xa3, 0xb6, 0x4a, 0xcb, 0x36, 0xed, 0xa2, 0x82, 0xa0, 0xa1, 0x95,
+	0xd1, 0x5a, 0x82, 0xd1, 0xa2, 0x28, 0xba, 0xb9, 0x6e, 0x0d, 0x6b, 0x09, 0x02, 0xda, 0xc8, 0x90,
+	0x85, 0x38, 0xf1, 0x3e, 0x49, 0x87, 0x90, 0x77, 0xcc, 0xf1, 0x28, 0xc3, 0xff, 0x42, 0xb2, 0x64,
+	0x0e, 0x90, 0x29, 0x4b, 0xf2, 0x9f, 0x64, 0xf4, 0x98, 0x31, 0xb0, 0xff, 0x8b, 0x4c, 0x01, 0x8f,
+	0x3c, 0x9a, 0x34, 0xe4, 0xc1, 0x9e, 0x32, 0x68, 0xb2, 0xbf, 0xf7, 0x8e, 0xbc, 0xc7, 0xf7, 0xbe,
+	0xfb, 0x44, 0xa2, 0x9f, 0x43, 0xec, 0xbd, 0x00, 0x39, 0xe1, 0xe2, 0x02, 0x0b, 0x32, 0x9c, 0x1f,
+	0x0e, 0x61, 0x0e, 0x4c, 0x46, 0x83, 0x50, 0x70, 0xc9, 0xad, 0x56, 0x89, 0x1e, 0xcc, 0x0f, 0x7b,
+	0x1f, 0x4c, 0xb4, 0xf5, 0x7f, 0xb2, 0xe4, 0x24, 0xc5, 0x46, 0x8c, 0x4a, 0x8a, 0x25, 0x10, 0xeb,
+	0x17, 0xb4, 0x41, 0xd9, 0x98, 0xc7, 0x8c, 0xb8, 0x21, 0x17, 0xd2, 0xa5, 0xc4, 0x36, 0xba, 0x46,
+	0xbf, 0xe9, 0xfc, 0x90, 0xc1, 0x4f, 0xb9, 0x90, 0x23, 0x62, 0xfd, 0x8e, 0x2c, 0xbd, 0xce, 0x9b,
+	0x61, 0xc6, 0xc0, 0x4f, 0x96, 0xae, 0xaa, 0xa5, 0xad, 0x8c, 0x39, 0x4e, 0x89, 0x11, 0xb1, 0xf6,
+	0x91, 0xc6, 0xdc, 0x08, 0x5e, 0xc6, 0xc0, 0x3c, 0xb0, 0xcd, 0xae, 0xd1, 0xaf, 0x38, 0x7a, 0xb7,
+	0xb3, 0x0c, 0xb6, 0xfa, 0xa8, 0xc5, 0x63, 0x59, 0x56, 0x50, 0x51, 0xb7, 0x5d, 0xd7, 0x78, 0x26,
+	0x61, 0x80, 0x36, 0xf3, 0x95, 0x05, 0x0d, 0x55, 0xb5, 0xf8, 0x47, 0x4d, 0xdd, 0x8a, 0xf8, 0x0d,
+	0xe5, 0xe0, 0xad, 0x8a, 0x9a, 0x52, 0x91, 0x6f, 0x99, 0xcb, 0xd8, 0x43, 0x0d, 0x01, 0x1e, 0xd0,
+	0x39, 0x08, 0xbb, 0xae, 0xee, 0x98, 0xd7, 0x56, 0x1b, 0x55, 0x09, 0x30, 0x1e, 0xd8, 0x0d, 0x45,
+	0xa4, 0x85, 0xb5, 0x8d, 0x6a, 0x38, 0xe0, 0x31, 0x93, 0x76, 0x53, 0xc1, 0x59, 0x65, 0xb5, 0x90,
+	0x39, 0x01, 0xb0, 0x91, 0x02, 0x93, 0x7f, 0x13, 0x21, 0x02, 0x7c, 0x7c, 0x09, 0xc2, 0xa5, 0xcc,
+	0x03, 0x26, 0xe9, 0x1c, 0xec, 0xb5, 0xd4, 0xba, 0x8c, 0x18, 0x69, 0xbc, 0xf7, 0xd5, 0x40, 0x9b,
+	0xc5, 0xa8, 0x4e, 0x31, 0x23, 0xfe, 0xf7, 0x10, 0x94, 0x8d, 0xea, 0x33, 0xa5, 0x45, 0x64, 0xf9,
+	0xe8, 0xb2, 0xe4, 0x5d, 0xf5, 0x3e, 0xef, 0x6a, 0x8b, 0xbd, 0xab, 0x17, 0xbd, 0xeb, 0xbd, 0x32,
+	0xcb, 0x0f, 0xef, 0x80, 0x14, 0x74, 0xd9, 0xa5, 0xb9, 0x8c, 0xdc, 0xcd, 0xfa, 0x62, 0x37, 0x1b,
+	0x8b, 0x3a, 0xb1, 0x79, 0xa7, 0x13, 0x13, 0x4b, 0x23, 0x57, 0x40, 0x80, 0x29, 0xa3, 0x6c, 0xaa,
+	0x3a, 0xb5, 0xea, 0xb4, 0x32, 0xc2, 0xd1, 0x78, 0xef, 0xb5, 0x89, 0xda, 0xe5, 0x30, 0x26, 0x31,
+	0x23, 0xcb, 0x34, 0x1e, 0x99, 0x46, 0x1b, 0x55, 0x41, 0x08, 0x2e, 0xb2, 0x3c, 0xd2, 0xc2, 0xfa,
+	0x15, 0x6d, 0x08, 0x98, 0x94, 0xb6, 0x43, 0x6a, 0xbb, 0xf5, 0x14, 0xd6, 0x9b, 0xf5, 0x3e, 0xde,
+	0x19, 0xe1, 0x0e, 0x78, 0x7c, 0x0e, 0x62, 0x19, 0xc7, 0x23, 0xe3, 0xd8, 0x47, 0x2d, 0x91, 0x5a,
+	0x78, 0xe9, 0x62, 0x42, 0x04, 0x44, 0x51, 0x96, 0xcc, 0x86, 0xc6, 0x8f, 0x52, 0xf8, 0x36, 0x39,
+	0x54, 0x4c, 0x6e, 0x17, 0x35, 0x3c, 0x1f, 0xd3, 0x20, 0x79, 0x80, 0x35, 0x25, 0xa9, 0xae, 0xea,
+	0x11, 0xe9, 0xbd, 0x5b, 0x2d, 0x8f, 0xb1, 0x63, 0x1f, 0xf0, 0x32, 0xa9, 0x42, 0x2f, 0x1b, 0xa8,
+	0x5b, 0xf4, 0xe7, 0x3f, 0xf0, 0xa9, 0xea, 0xe5, 0xa3, 0x89, 0x04, 0x91, 0xce, 0x99, 0x85, 0x5a,
+	0x8d, 0x87, 0x68, 0x5d, 0x7d, 0x90, 0x56, 0xf3, 0x1e, 0xad, 0x6f, 0x4d, 0xd4, 0x29, 0xbf, 0x3a,
+	0x29, 0xfe, 0x9c, 0x06, 0xc0, 0x63, 0xf9, 0x04, 0xb0, 0x58, 0xc6, 0x9a, 0xc9, 0xf8, 0x13, 0x6d,
+	0x6b, 0xc5, 0x32, 0xb5, 0xc7, 0x9d, 0x01, 0x9d, 0xce, 0xf4, 0xaf, 0x7c, 0x9b, 0x96, 0xbc, 0x3b,
+	0x55, 0x9c, 0xf5, 0x0f, 0xda, 0xbd, 0x7b, 0x55, 0xf2, 0x37, 0x92, 0x38, 0x08, 0xd5, 0x99, 0xad,
+	0x38, 0x3b, 0xe5, 0x0b, 0xcf, 0x35, 0xdd, 0x7b, 0x6f, 0xa0, 0x3d, 0x15, 0x4e, 0x3e, 0x0d, 0x4f,
+	0x62, 0x46, 0xa2, 0xe3, 0xe4, 0x1c, 0x02, 0xb1, 0xfe, 0x42, 0x3b, 0x5c, 0xd0, 0x29, 0x65, 0xd8,
+	0x77, 0x23, 0x60, 0x04, 0x44, 0x7e, 0xd4, 0xd3, 0x80, 0xb6, 0x34, 0x7d, 0xa6, 0x58, 0x7d, 0xe0,
+	0x6d, 0x94, 0x1e, 0x65, 0x10, 0x59, 0x3a, 0xba, 0x2c, 0x4c, 0x13, 0xb3, 0x34, 0x4d, 0x7e, 0x42,
+	0x4d, 0x3d, 0x0c, 0x22, 0xbb, 0xd2, 0x35, 0xfb, 0x15, 0xa7, 0x91, 0x4d, 0x83, 0xe8, 0xdf, 0xf0,
+	0xd3, 0x75, 0xc7, 0xb8, 0xba, 0xee, 0x18, 0x5f, 0xae, 0x3b, 0xc6, 0x9b, 0x9b, 0xce, 0xca, 0xd5,
+	0x4d, 0x67, 0xe5, 0xf3, 0x4d, 0x67, 0xe5, 0xf9, 0xb3, 0x29, 0x95, 0xb3, 0x78, 0x3c, 0xf0, 0x78,
+	0x30, 0xf4, 0x78, 0x14, 0xf0, 0x68, 0x48, 0xc7, 0xde, 0x01, 0x0e, 0xc3, 0x68, 0x18, 0x50, 0x42,
+	0x7c, 0xb8, 0xc0, 0x02, 0x86, 0xe9, 0xfb, 0xfc, 0x41, 0xf6, 0x42, 0x7f, 0x50, 0x60, 0xe6, 0x7f,
+	0x0f, 0xcb, 0xdf, 0x02, 0xf2, 0x32, 0x84, 0x68, 0x5c, 0x53, 0x1f, 0x02, 0x7f, 0x7c, 0x1b, 0x00,
+	0x49, 0xb9, 0xc2, 0xee, 0x29, 0x0c, 0x00, 0x00,
+}
+
+func (m *EventForwardInitiated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardInitiated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardInitiated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayerIncentive) > 0 {
+		i -= len(m.RelayerIncentive)
+		copy(dAtA[i:], m.RelayerIncentive)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RelayerIncentive)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardHandled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardHandled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardHandled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Handler) > 0 {
+		i -= len(m.Handler)
+		copy(dAtA[i:], m.Handler)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Handler)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardRecovered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardRecovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardRecovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ClaimId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RecoveryAddress) > 0 {
+		i -= len(m.RecoveryAddress)
+		copy(dAtA[i:], m.RecoveryAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecoveryAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardDeliveredAfterRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardDeliveredAfterRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardDeliveredAfterRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardInboundTimeoutNear) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardInboundTimeoutNear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardInboundTimeoutNear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InboundTimeoutTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.InboundTimeoutHeight) > 0 {
+		i -= len(m.InboundTimeoutHeight)
+		copy(dAtA[i:], m.InboundTimeoutHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundTimeoutHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OutboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OutboundPortId) > 0 {
+		i -= len(m.OutboundPortId)
+		copy(dAtA[i:], m.OutboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecoveredFundsClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecoveredFundsClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecoveredFundsClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimIds) > 0 {
+		dAtA2 := make([]byte, len(m.ClaimIds)*10)
+		var j1 int
+		for _, num := range m.ClaimIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventForwardInitiated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RelayerIncentive)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventForwardHandled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventForwardRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovEvents(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func (m *EventForwardRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.RefundSequence))
+	}
+	return n
+}
+
+func (m *EventForwardRecovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ClaimId != 0 {
+		n += 1 + sovEvents(uint64(m.ClaimId))
+	}
+	return n
+}
+
+func (m *EventForwardCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	return n
+}
+
+func (m *EventForwardDeliveredAfterRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	return n
+}
+
+func (m *EventForwardInboundTimeoutNear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.InboundSequence))
+	}
+	l = len(m.OutboundPortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundSequence))
+	}
+	l = len(m.InboundTimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InboundTimeoutTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.InboundTimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *EventRecoveredFundsClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ClaimIds) > 0 {
+		l = 0
+		for _, e := range m.ClaimIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventForwardInitiated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardInitiated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardInitiated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerIncentive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerIncentive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardHandled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardHandled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardHandled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardRecovered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardRecovered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+			}
+			m.ClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardDeliveredAfterRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardDeliveredAfterRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardDeliveredAfterRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardInboundTimeoutNear) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardInboundTimeoutNear: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardInboundTimeoutNear: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTimeoutTimestamp", wireType)
+			}
+			m.InboundTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecoveredFundsClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecoveredFundsClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecoveredFundsClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClaimIds = append(m.ClaimIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClaimIds) == 0 {
+					m.ClaimIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClaimIds = append(m.ClaimIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
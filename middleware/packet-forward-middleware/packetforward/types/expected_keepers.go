@@ -6,8 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // TransferKeeper defines the expected transfer keeper
@@ -16,6 +18,8 @@ type TransferKeeper interface {
 	DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
 	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
+	GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins
+	GetPort(ctx sdk.Context) string
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
@@ -24,6 +28,14 @@ type ChannelKeeper interface {
 	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// FeeKeeper defines the expected ICS-29 fee keeper
+type FeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	PayPacketFeeAsync(ctx context.Context, msg *feetypes.MsgPayPacketFeeAsync) (*feetypes.MsgPayPacketFeeAsyncResponse, error)
 }
 
 // DistributionKeeper defines the expected distribution keeper
@@ -38,4 +50,5 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
@@ -8,6 +8,10 @@ import (
 
 	"github.com/iancoleman/orderedmap"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -21,15 +25,76 @@ type ForwardMetadata struct {
 	Channel  string   `json:"channel,omitempty"`
 	Timeout  Duration `json:"timeout,omitempty"`
 	Retries  *uint8   `json:"retries,omitempty"`
+	Backoff  *Backoff `json:"backoff,omitempty"`
+
+	// RecoverAddress, if set, receives the funds on this chain if the forward fails after the packet can no
+	// longer be refunded. It must be a bech32 account address of this chain.
+	RecoverAddress string `json:"recover_address,omitempty"`
+
+	// Handler, if set, names the ForwardHandler registered by the app that receives the funds on this chain
+	// instead of forwarding them on the port and channel above, which must then be empty. The next memo is
+	// passed to the handler.
+	Handler string `json:"handler,omitempty"`
+
+	// Splits, if set, split the packet across several forwards instead of forwarding it to the
+	// receiver, port and channel above. Timeout, retries, backoff and recover address apply to splits that do not
+	// set their own.
+	Splits []ForwardSplit `json:"splits,omitempty"`
 
 	// Using JSONObject so that objects for next property will not be mutated by golang's lexicographic key sort on map keys during Marshal.
 	// Supports primitives for Unmarshal/Marshal so that an escaped JSON-marshaled string is also valid.
 	Next *JSONObject `json:"next,omitempty"`
 }
 
+// ForwardSplit is one of the forwards a packet is split across. Exactly one of Amount, a fixed amount,
+// or Percentage, a share of the amount left after fixed amounts, must be set.
+type ForwardSplit struct {
+	ForwardMetadata
+
+	Amount     string `json:"amount,omitempty"`
+	Percentage string `json:"percentage,omitempty"`
+}
+
+// ForwardBranch is a forward of an amount out of an inbound packet.
+type ForwardBranch struct {
+	Metadata *ForwardMetadata
+	Amount   sdkmath.Int
+}
+
 type Duration time.Duration
 
+// Backoff overrides the module's retry backoff policy for a forward.
+type Backoff struct {
+	Strategy   string   `json:"strategy,omitempty"`
+	MaxTimeout Duration `json:"max_timeout,omitempty"`
+}
+
+// RetryBackoff converts the backoff metadata to the policy stored with the in-flight packet.
+func (b Backoff) RetryBackoff() (RetryBackoff, error) {
+	strategy, err := ParseBackoffStrategy(b.Strategy)
+	if err != nil {
+		return RetryBackoff{}, err
+	}
+
+	backoff := RetryBackoff{
+		Strategy:   strategy,
+		MaxTimeout: time.Duration(b.MaxTimeout),
+	}
+	return backoff, backoff.Validate()
+}
+
 func (m *ForwardMetadata) Validate() error {
+	if m.RecoverAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RecoverAddress); err != nil {
+			return fmt.Errorf("failed to validate metadata: invalid recover address: %w", err)
+		}
+	}
+	if len(m.Splits) > 0 {
+		return m.validateSplits()
+	}
+	if m.Handler != "" {
+		return m.validateHandler()
+	}
 	if m.Receiver == "" {
 		return fmt.Errorf("failed to validate metadata. receiver cannot be empty")
 	}
@@ -39,10 +104,208 @@ func (m *ForwardMetadata) Validate() error {
 	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
 		return fmt.Errorf("failed to validate metadata: %w", err)
 	}
+	if m.Backoff != nil {
+		if _, err := m.Backoff.RetryBackoff(); err != nil {
+			return fmt.Errorf("failed to validate metadata: %w", err)
+		}
+	}
 
 	return nil
 }
 
+// validateHandler asserts that the forward to a handler does not also forward to a port and channel.
+func (m *ForwardMetadata) validateHandler() error {
+	if m.Port != "" || m.Channel != "" {
+		return fmt.Errorf("failed to validate metadata: handler cannot be combined with port or channel")
+	}
+	if m.Retries != nil || m.Backoff != nil {
+		return fmt.Errorf("failed to validate metadata: handler cannot be combined with retries or backoff")
+	}
+
+	return nil
+}
+
+// validateSplits asserts that the forward only defines valid splits, each with either a fixed amount or a
+// percentage, and that the percentages add up to one.
+func (m *ForwardMetadata) validateSplits() error {
+	if m.Receiver != "" || m.Port != "" || m.Channel != "" || m.Handler != "" || m.Next != nil {
+		return fmt.Errorf("failed to validate metadata: splits cannot be combined with receiver, port, channel, handler or next")
+	}
+
+	totalPercentage := sdkmath.LegacyZeroDec()
+	hasPercentage := false
+	for i, split := range m.Splits {
+		if len(split.Splits) > 0 {
+			return fmt.Errorf("failed to validate metadata: split %d cannot be split further", i)
+		}
+		if err := split.ForwardMetadata.Validate(); err != nil {
+			return fmt.Errorf("split %d: %w", i, err)
+		}
+
+		switch {
+		case split.Amount != "" && split.Percentage == "":
+			amount, ok := sdkmath.NewIntFromString(split.Amount)
+			if !ok || !amount.IsPositive() {
+				return fmt.Errorf("failed to validate metadata: split %d amount must be a positive integer, got %q", i, split.Amount)
+			}
+		case split.Percentage != "" && split.Amount == "":
+			percentage, err := sdkmath.LegacyNewDecFromStr(split.Percentage)
+			if err != nil || !percentage.IsPositive() || percentage.GT(sdkmath.LegacyOneDec()) {
+				return fmt.Errorf("failed to validate metadata: split %d percentage must be in (0, 1], got %q", i, split.Percentage)
+			}
+			totalPercentage = totalPercentage.Add(percentage)
+			hasPercentage = true
+		default:
+			return fmt.Errorf("failed to validate metadata: split %d must set exactly one of amount or percentage", i)
+		}
+	}
+
+	if hasPercentage && !totalPercentage.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("failed to validate metadata: split percentages must add up to 1, got %s", totalPercentage)
+	}
+
+	return nil
+}
+
+// Branches returns the forwards of the validated metadata with the amount each forwards out of the total.
+// Without splits this is the forward itself with the total. Otherwise fixed amounts are taken first and
+// the remainder is shared by percentage, with rounding dust going to the last split with a percentage.
+func (m *ForwardMetadata) Branches(total sdkmath.Int) ([]ForwardBranch, error) {
+	if len(m.Splits) == 0 {
+		return []ForwardBranch{{Metadata: m, Amount: total}}, nil
+	}
+
+	branches := make([]ForwardBranch, len(m.Splits))
+	remainder := total
+	lastPercentage := -1
+	for i, split := range m.Splits {
+		metadata := split.ForwardMetadata
+		if metadata.Timeout == 0 {
+			metadata.Timeout = m.Timeout
+		}
+		if metadata.Retries == nil {
+			metadata.Retries = m.Retries
+		}
+		if metadata.Backoff == nil {
+			metadata.Backoff = m.Backoff
+		}
+		if metadata.RecoverAddress == "" {
+			metadata.RecoverAddress = m.RecoverAddress
+		}
+		branches[i].Metadata = &metadata
+
+		if split.Amount != "" {
+			branches[i].Amount, _ = sdkmath.NewIntFromString(split.Amount)
+			remainder = remainder.Sub(branches[i].Amount)
+		} else {
+			lastPercentage = i
+		}
+	}
+
+	if remainder.IsNegative() {
+		return nil, fmt.Errorf("split amounts exceed the packet amount %s", total)
+	}
+	if lastPercentage < 0 && !remainder.IsZero() {
+		return nil, fmt.Errorf("split amounts do not add up to the packet amount %s", total)
+	}
+
+	shared := sdkmath.ZeroInt()
+	for i, split := range m.Splits {
+		if split.Percentage == "" {
+			continue
+		}
+
+		if i == lastPercentage {
+			branches[i].Amount = remainder.Sub(shared)
+		} else {
+			percentage, _ := sdkmath.LegacyNewDecFromStr(split.Percentage)
+			branches[i].Amount = sdkmath.LegacyNewDecFromInt(remainder).Mul(percentage).TruncateInt()
+			shared = shared.Add(branches[i].Amount)
+		}
+	}
+
+	for i, branch := range branches {
+		if !branch.Amount.IsPositive() {
+			return nil, fmt.Errorf("split %d of the packet amount %s is empty", i, total)
+		}
+	}
+
+	return branches, nil
+}
+
+// depth returns the number of hops the metadata forwards a packet through, counting this forward and the
+// forwards nested in next, or of the deepest split. Forwards nested deeper than limit are not resolved.
+func (m *ForwardMetadata) depth(limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+
+	if len(m.Splits) > 0 {
+		depth := 0
+		for _, split := range m.Splits {
+			depth = max(depth, split.ForwardMetadata.depth(limit))
+		}
+		return depth
+	}
+
+	if m.Next == nil {
+		return 1
+	}
+
+	// the next memo is walked as decoded rather than encoded again, which is quadratic in its depth.
+	next := m.Next.orderedMap
+	if !m.Next.obj {
+		next = orderedmap.OrderedMap{}
+		if err := next.UnmarshalJSON(m.Next.primitive); err != nil {
+			return 1
+		}
+	}
+	return 1 + memoDepth(next, limit-1)
+}
+
+// memoDepth returns the number of hops the forward metadata of a decoded memo forwards a packet through.
+func memoDepth(memo orderedmap.OrderedMap, limit int) int {
+	value, _ := memo.Get("forward")
+	forward, ok := value.(orderedmap.OrderedMap)
+	if !ok {
+		// the memo does not forward the packet further.
+		return 0
+	}
+	return forwardDepth(forward, limit)
+}
+
+// forwardDepth returns the number of hops a decoded forward metadata forwards a packet through, up to limit.
+func forwardDepth(forward orderedmap.OrderedMap, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+
+	value, _ := forward.Get("splits")
+	if splits, ok := value.([]interface{}); ok && len(splits) > 0 {
+		depth := 0
+		for _, s := range splits {
+			if split, ok := s.(orderedmap.OrderedMap); ok {
+				depth = max(depth, forwardDepth(split, limit))
+			}
+		}
+		return depth
+	}
+
+	value, _ = forward.Get("next")
+	switch next := value.(type) {
+	case orderedmap.OrderedMap:
+		return 1 + memoDepth(next, limit-1)
+	case string:
+		var nextMap orderedmap.OrderedMap
+		if err := nextMap.UnmarshalJSON([]byte(next)); err != nil {
+			return 1
+		}
+		return 1 + memoDepth(nextMap, limit-1)
+	default:
+		return 1
+	}
+}
+
 // JSONObject is a wrapper type to allow either a primitive type or a JSON object.
 // In the case the value is a JSON object, OrderedMap type is used so that key order
 // is retained across Unmarshal/Marshal.
@@ -3,9 +3,12 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 func TestForwardMetadataUnmarshalStringNext(t *testing.T) {
@@ -57,3 +60,159 @@ func TestTimeoutUnmarshalJSON(t *testing.T) {
 
 	require.Equal(t, "60000000000", string(timeoutBz))
 }
+
+func TestBackoffUnmarshal(t *testing.T) {
+	const memo = "{\"forward\":{\"receiver\":\"noble1f4cur2krsua2th9kkp7n0zje4stea4p9tu70u8\",\"port\":\"transfer\",\"channel\":\"channel-0\",\"backoff\":{\"strategy\":\"exponential\",\"max_timeout\":\"1h\"}}}"
+	var packetMetadata types.PacketMetadata
+
+	err := json.Unmarshal([]byte(memo), &packetMetadata)
+	require.NoError(t, err)
+	require.NoError(t, packetMetadata.Forward.Validate())
+
+	backoff, err := packetMetadata.Forward.Backoff.RetryBackoff()
+	require.NoError(t, err)
+	require.Equal(t, types.RetryBackoff{Strategy: types.BackoffExponential, MaxTimeout: time.Hour}, backoff)
+
+	packetMetadata.Forward.Backoff.Strategy = "fibonacci"
+	require.Error(t, packetMetadata.Forward.Validate())
+}
+
+func TestForwardMetadataBranches(t *testing.T) {
+	split := func(channel, amount, percentage string) types.ForwardSplit {
+		return types.ForwardSplit{
+			ForwardMetadata: types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: channel},
+			Amount:          amount,
+			Percentage:      percentage,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		splits   []types.ForwardSplit
+		expected []int64
+		expErr   bool
+	}{
+		{"percentages", []types.ForwardSplit{split("channel-0", "", "0.5"), split("channel-1", "", "0.5")}, []int64{50, 51}, false},
+		{"amount and percentages", []types.ForwardSplit{split("channel-0", "", "0.3"), split("channel-1", "11", ""), split("channel-2", "", "0.7")}, []int64{27, 11, 63}, false},
+		{"amounts", []types.ForwardSplit{split("channel-0", "1", ""), split("channel-1", "100", "")}, []int64{1, 100}, false},
+		{"amounts exceed total", []types.ForwardSplit{split("channel-0", "102", ""), split("channel-1", "", "1")}, nil, true},
+		{"amounts below total", []types.ForwardSplit{split("channel-0", "100", "")}, nil, true},
+		{"empty split", []types.ForwardSplit{split("channel-0", "101", ""), split("channel-1", "", "1")}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := &types.ForwardMetadata{Splits: tc.splits}
+			require.NoError(t, metadata.Validate())
+
+			branches, err := metadata.Branches(sdkmath.NewInt(101))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			amounts := make([]int64, len(branches))
+			for i, branch := range branches {
+				amounts[i] = branch.Amount.Int64()
+				require.Equal(t, tc.splits[i].Channel, branch.Metadata.Channel)
+			}
+			require.Equal(t, tc.expected, amounts)
+		})
+	}
+}
+
+func TestForwardMetadataValidateSplits(t *testing.T) {
+	split := types.ForwardSplit{
+		ForwardMetadata: types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-0"},
+		Percentage:      "1",
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.ForwardMetadata)
+	}{
+		{"top-level channel", func(m *types.ForwardMetadata) { m.Channel = "channel-1" }},
+		{"amount and percentage", func(m *types.ForwardMetadata) { m.Splits[0].Amount = "1" }},
+		{"neither amount nor percentage", func(m *types.ForwardMetadata) { m.Splits[0].Percentage = "" }},
+		{"invalid amount", func(m *types.ForwardMetadata) { m.Splits[0].Percentage, m.Splits[0].Amount = "", "-1" }},
+		{"percentages below one", func(m *types.ForwardMetadata) { m.Splits[0].Percentage = "0.9" }},
+		{"invalid split", func(m *types.ForwardMetadata) { m.Splits[0].Receiver = "" }},
+		{"nested splits", func(m *types.ForwardMetadata) { m.Splits[0].Splits = []types.ForwardSplit{split} }},
+		{"top-level handler", func(m *types.ForwardMetadata) { m.Handler = "swap" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := &types.ForwardMetadata{Splits: []types.ForwardSplit{split}}
+			require.NoError(t, metadata.Validate())
+
+			tc.malleate(metadata)
+			require.Error(t, metadata.Validate())
+		})
+	}
+}
+
+func TestForwardMetadataValidateHandler(t *testing.T) {
+	retries := uint8(1)
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.ForwardMetadata)
+	}{
+		{"port", func(m *types.ForwardMetadata) { m.Port = "transfer" }},
+		{"channel", func(m *types.ForwardMetadata) { m.Channel = "channel-0" }},
+		{"retries", func(m *types.ForwardMetadata) { m.Retries = &retries }},
+		{"backoff", func(m *types.ForwardMetadata) { m.Backoff = &types.Backoff{Strategy: "linear"} }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the receiver is optional and left to the handler.
+			metadata := &types.ForwardMetadata{Handler: "swap"}
+			require.NoError(t, metadata.Validate())
+
+			tc.malleate(metadata)
+			require.Error(t, metadata.Validate())
+		})
+	}
+}
+
+func TestForwardSplitUnmarshal(t *testing.T) {
+	const memo = "{\"forward\":{\"timeout\":\"10m\",\"splits\":[{\"receiver\":\"cosmos1receiver\",\"port\":\"transfer\",\"channel\":\"channel-0\",\"percentage\":\"0.25\"},{\"receiver\":\"cosmos1receiver\",\"port\":\"transfer\",\"channel\":\"channel-1\",\"timeout\":\"1h\",\"percentage\":\"0.75\"}]}}"
+	var packetMetadata types.PacketMetadata
+
+	err := json.Unmarshal([]byte(memo), &packetMetadata)
+	require.NoError(t, err)
+	require.NoError(t, packetMetadata.Forward.Validate())
+
+	branches, err := packetMetadata.Forward.Branches(sdkmath.NewInt(100))
+	require.NoError(t, err)
+	require.Len(t, branches, 2)
+	require.Equal(t, types.Duration(10*time.Minute), branches[0].Metadata.Timeout)
+	require.Equal(t, types.Duration(time.Hour), branches[1].Metadata.Timeout)
+	require.Equal(t, sdkmath.NewInt(75), branches[1].Amount)
+}
+
+func TestForwardMetadataValidateRecoverAddress(t *testing.T) {
+	metadata := &types.ForwardMetadata{
+		Receiver:       "cosmos1receiver",
+		Port:           "transfer",
+		Channel:        "channel-0",
+		RecoverAddress: "cosmos1v954djef63x2lqj8yy7r3r487heg0exdmkj0sr",
+	}
+	require.NoError(t, metadata.Validate())
+
+	metadata.RecoverAddress = "0x6ab3a38c6a9b7d5cc4c1cd4b0e1d1e2a3f5a9d7c"
+	require.ErrorContains(t, metadata.Validate(), "invalid recover address")
+
+	// the recover address of splits is validated as well.
+	split := &types.ForwardMetadata{
+		RecoverAddress: metadata.RecoverAddress,
+		Splits: []types.ForwardSplit{{
+			ForwardMetadata: types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-0"},
+			Percentage:      "1",
+		}},
+	}
+	require.ErrorContains(t, split.Validate(), "invalid recover address")
+}
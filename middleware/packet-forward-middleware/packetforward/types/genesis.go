@@ -1,7 +1,19 @@
 package types
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // NewGenesisState creates a pfm GenesisState instance.
-func NewGenesisState(params Params, inFlightPackets map[string]InFlightPacket) *GenesisState {
+func NewGenesisState(params Params, inFlightPackets []InFlightPacketEntry) *GenesisState {
 	return &GenesisState{
 		Params:          params,
 		InFlightPackets: inFlightPackets,
@@ -12,11 +24,227 @@ func NewGenesisState(params Params, inFlightPackets map[string]InFlightPacket) *
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:          DefaultParams(),
-		InFlightPackets: make(map[string]InFlightPacket),
+		InFlightPackets: []InFlightPacketEntry{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPackets := make(map[string]bool, len(gs.InFlightPackets))
+	for _, entry := range gs.InFlightPackets {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicate in-flight packet %s", key)
+		}
+		seenPackets[key] = true
+	}
+
+	seenClaims := make(map[uint64]bool, len(gs.RecoveredFundsClaims))
+	for _, claim := range gs.RecoveredFundsClaims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+
+		if seenClaims[claim.Id] {
+			return fmt.Errorf("duplicate recovered funds claim %d", claim.Id)
+		}
+		seenClaims[claim.Id] = true
+
+		if claim.Id >= gs.NextRecoveredFundsClaimId {
+			return fmt.Errorf("recovered funds claim %d is not below the next claim id %d", claim.Id, gs.NextRecoveredFundsClaimId)
+		}
+	}
+
+	seenDelegates := make(map[string]bool, len(gs.ClaimDelegates))
+	for _, delegate := range gs.ClaimDelegates {
+		if err := delegate.Validate(); err != nil {
+			return err
+		}
+
+		if seenDelegates[delegate.OriginalSenderAddress] {
+			return fmt.Errorf("duplicate claim delegate for %s", delegate.OriginalSenderAddress)
+		}
+		seenDelegates[delegate.OriginalSenderAddress] = true
+	}
+
+	seenFlows := make(map[string]bool, len(gs.RateLimitFlows))
+	for _, entry := range gs.RateLimitFlows {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		// flows of rate limits missing from params would be pruned on the next params update.
+		if _, found := gs.Params.RateLimit(entry.ChannelId, entry.Denom); !found {
+			return fmt.Errorf("rate limit flow on %s for %s has no rate limit in params", entry.ChannelId, entry.Denom)
+		}
+
+		key := string(RateLimitFlowKey(entry.ChannelId, entry.Denom))
+		if seenFlows[key] {
+			return fmt.Errorf("duplicate rate limit flow on %s for %s", entry.ChannelId, entry.Denom)
+		}
+		seenFlows[key] = true
+	}
+
+	seenForceRefunded := make(map[string]bool, len(gs.ForceRefundedPackets))
+	for _, packet := range gs.ForceRefundedPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RefundPacketKey(packet.ChannelId, packet.PortId, packet.Sequence))
+		if seenForceRefunded[key] {
+			return fmt.Errorf("duplicate force refunded packet %s", key)
+		}
+		seenForceRefunded[key] = true
+	}
+
+	seenResults := make(map[string]bool, len(gs.SplitForwardResults))
+	for _, entry := range gs.SplitForwardResults {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		key := string(SplitForwardResultKey(
+			entry.InboundChannelId, entry.InboundPortId, entry.InboundSequence,
+			entry.Result.ChannelId, entry.Result.PortId, entry.Result.Sequence,
+		))
+		if seenResults[key] {
+			return fmt.Errorf("duplicate split forward result %s", key[len(SplitForwardResultPrefix):])
+		}
+		seenResults[key] = true
+	}
+
+	return nil
+}
+
+// Validate checks the channel, port and sequence of the forwarded packet and the inbound packet its in-flight
+// packet refers to.
+func (e InFlightPacketEntry) Validate() error {
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel id of in-flight packet on %s/%d", e.PortId, e.Sequence)
+	}
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port id of in-flight packet on %s/%d", e.ChannelId, e.Sequence)
+	}
+	if e.Sequence == 0 {
+		return fmt.Errorf("invalid sequence of in-flight packet on %s/%s: sequence cannot be 0", e.ChannelId, e.PortId)
+	}
+
+	id := RefundPacketKey(e.ChannelId, e.PortId, e.Sequence)
+	if err := host.ChannelIdentifierValidator(e.InFlightPacket.RefundChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid refund channel id of in-flight packet %s", id)
+	}
+	if err := host.PortIdentifierValidator(e.InFlightPacket.RefundPortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid refund port id of in-flight packet %s", id)
+	}
+
+	return nil
+}
+
+// Validate checks that the claim holds a valid amount for an original sender.
+func (c RecoveredFundsClaim) Validate() error {
+	if c.Id == 0 {
+		return fmt.Errorf("invalid recovered funds claim id: id cannot be 0")
+	}
+	if c.OriginalSenderAddress == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "original sender of recovered funds claim %d cannot be empty", c.Id)
+	}
+	if !c.Amount.IsValid() || c.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount of recovered funds claim %d: %s", c.Id, c.Amount)
+	}
+
+	return nil
+}
+
+// Validate checks that the delegate is a valid account address for an original sender.
+func (d ClaimDelegate) Validate() error {
+	if d.OriginalSenderAddress == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "original sender of claim delegate cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Delegate); err != nil {
+		return errorsmod.Wrapf(err, "invalid claim delegate for %s", d.OriginalSenderAddress)
+	}
+
+	return nil
+}
+
+// Validate checks the outbound channel and denom of the rate limit flow and the amount forwarded.
+func (e RateLimitFlowEntry) Validate() error {
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel id of rate limit flow for %s", e.Denom)
+	}
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return errorsmod.Wrapf(err, "invalid denom of rate limit flow on %s", e.ChannelId)
+	}
+	if e.Flow.Amount.IsNil() || e.Flow.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount of rate limit flow on %s for %s: %s", e.ChannelId, e.Denom, e.Flow.Amount)
+	}
+
+	return nil
+}
+
+// Validate checks the channel, port and sequence of the force refunded packet.
+func (p ForceRefundedPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel id of force refunded packet on %s/%d", p.PortId, p.Sequence)
+	}
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port id of force refunded packet on %s/%d", p.ChannelId, p.Sequence)
+	}
+	if p.Sequence == 0 {
+		return fmt.Errorf("invalid sequence of force refunded packet on %s/%s: sequence cannot be 0", p.ChannelId, p.PortId)
+	}
+
+	return nil
+}
+
+// Validate checks the channel, port and sequence of the inbound packet and of the forwarded packet the split
+// forward result is the outcome of, or the split index of a split delivered to a handler, and its amount.
+func (e SplitForwardResultEntry) Validate() error {
+	if err := host.ChannelIdentifierValidator(e.InboundChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid inbound channel id of split forward result on %s/%d", e.InboundPortId, e.InboundSequence)
+	}
+	if err := host.PortIdentifierValidator(e.InboundPortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid inbound port id of split forward result on %s/%d", e.InboundChannelId, e.InboundSequence)
+	}
+	if e.InboundSequence == 0 {
+		return fmt.Errorf("invalid inbound sequence of split forward result on %s/%s: sequence cannot be 0", e.InboundChannelId, e.InboundPortId)
+	}
+
+	id := RefundPacketKey(e.InboundChannelId, e.InboundPortId, e.InboundSequence)
+	if e.Result.Handler != "" {
+		// splits delivered to a handler are identified by their index, which starts at 0.
+		if e.Result.ChannelId != "" || e.Result.PortId != "" {
+			return fmt.Errorf("invalid split forward result of %s: handled split %d cannot have a channel or port", id, e.Result.Sequence)
+		}
+		if !e.Result.Success {
+			return fmt.Errorf("invalid split forward result of %s: handled split %d must have succeeded", id, e.Result.Sequence)
+		}
+	} else {
+		if err := host.ChannelIdentifierValidator(e.Result.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel id of split forward result of %s", id)
+		}
+		if err := host.PortIdentifierValidator(e.Result.PortId); err != nil {
+			return errorsmod.Wrapf(err, "invalid port id of split forward result of %s", id)
+		}
+		if e.Result.Sequence == 0 {
+			return fmt.Errorf("invalid sequence of split forward result of %s: sequence cannot be 0", id)
+		}
+	}
+	if e.Result.Denom == "" {
+		return fmt.Errorf("invalid denom of split forward result of %s: denom cannot be empty", id)
+	}
+	if amount, ok := sdkmath.NewIntFromString(e.Result.Amount); !ok || !amount.IsPositive() {
+		return fmt.Errorf("invalid amount of split forward result of %s: %q", id, e.Result.Amount)
+	}
+
+	return nil
 }
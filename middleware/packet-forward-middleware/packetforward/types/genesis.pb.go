@@ -6,18 +6,24 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,14 +31,102 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LoopDetection defines how forwards that would return tokens to a chain they
+// already passed through are handled.
+type LoopDetection int32
+
+const (
+	// LOOP_DETECTION_ALLOW forwards packets regardless of the chains their
+	// tokens passed through.
+	LoopDetectionAllow LoopDetection = 0
+	// LOOP_DETECTION_STRICT rejects forwards back over the channel the packet
+	// was received on, or to a chain the tokens were received from.
+	LoopDetectionStrict LoopDetection = 1
+)
+
+var LoopDetection_name = map[int32]string{
+	0: "LOOP_DETECTION_ALLOW",
+	1: "LOOP_DETECTION_STRICT",
+}
+
+var LoopDetection_value = map[string]int32{
+	"LOOP_DETECTION_ALLOW":  0,
+	"LOOP_DETECTION_STRICT": 1,
+}
+
+func (x LoopDetection) String() string {
+	return proto.EnumName(LoopDetection_name, int32(x))
+}
+
+func (LoopDetection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{0}
+}
+
+// BackoffStrategy defines how the timeout of a forward grows with each retry.
+type BackoffStrategy int32
+
+const (
+	// BACKOFF_STRATEGY_NONE retries with the same timeout each time.
+	BackoffNone BackoffStrategy = 0
+	// BACKOFF_STRATEGY_LINEAR adds the initial timeout with each retry.
+	BackoffLinear BackoffStrategy = 1
+	// BACKOFF_STRATEGY_EXPONENTIAL doubles the timeout with each retry.
+	BackoffExponential BackoffStrategy = 2
+)
+
+var BackoffStrategy_name = map[int32]string{
+	0: "BACKOFF_STRATEGY_NONE",
+	1: "BACKOFF_STRATEGY_LINEAR",
+	2: "BACKOFF_STRATEGY_EXPONENTIAL",
+}
+
+var BackoffStrategy_value = map[string]int32{
+	"BACKOFF_STRATEGY_NONE":        0,
+	"BACKOFF_STRATEGY_LINEAR":      1,
+	"BACKOFF_STRATEGY_EXPONENTIAL": 2,
+}
+
+func (x BackoffStrategy) String() string {
+	return proto.EnumName(BackoffStrategy_name, int32(x))
+}
+
+func (BackoffStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{1}
+}
+
 // GenesisState defines the packetforward genesis state
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// key - information about forwarded packet: src_channel
-	// (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence value -
-	// information about original packet for refunding if necessary: retries,
-	// srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
-	InFlightPackets map[string]InFlightPacket `protobuf:"bytes,2,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// in_flight_packets are the forwarded packets still awaiting an
+	// acknowledgement or timeout, with the channel, port and sequence they were
+	// forwarded on.
+	InFlightPackets []InFlightPacketEntry `protobuf:"bytes,3,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	// recovered_funds_claims are the pending claims of the funds of failed
+	// nonrefundable forwards held in the claims escrow account.
+	RecoveredFundsClaims []RecoveredFundsClaim `protobuf:"bytes,4,rep,name=recovered_funds_claims,json=recoveredFundsClaims,proto3" json:"recovered_funds_claims"`
+	// claim_delegates are the accounts approved to claim the recovered funds of
+	// original senders.
+	ClaimDelegates []ClaimDelegate `protobuf:"bytes,5,rep,name=claim_delegates,json=claimDelegates,proto3" json:"claim_delegates"`
+	// rate_limit_flows are the amounts forwarded in the current windows of the
+	// rate limits in params.
+	RateLimitFlows []RateLimitFlowEntry `protobuf:"bytes,6,rep,name=rate_limit_flows,json=rateLimitFlows,proto3" json:"rate_limit_flows"`
+	// force_refunded_packets are the forwarded packets whose inbound packet was
+	// refunded by the authority before they were acknowledged or timed out.
+	ForceRefundedPackets []ForceRefundedPacket `protobuf:"bytes,7,rep,name=force_refunded_packets,json=forceRefundedPackets,proto3" json:"force_refunded_packets"`
+	// split_forward_results are the outcomes of the completed forwards of
+	// inbound packets split across several forwards that have not all
+	// completed.
+	SplitForwardResults []SplitForwardResultEntry `protobuf:"bytes,8,rep,name=split_forward_results,json=splitForwardResults,proto3" json:"split_forward_results"`
+	// timeout_watchdog_timestamp is the inbound timeout timestamp up to which
+	// the timeout watchdog has alerted of in-flight packets.
+	TimeoutWatchdogTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_watchdog_timestamp,json=timeoutWatchdogTimestamp,proto3" json:"timeout_watchdog_timestamp,omitempty"`
+	// timeout_watchdog_height is the inbound timeout height up to which the
+	// timeout watchdog has alerted of in-flight packets.
+	TimeoutWatchdogHeight uint64 `protobuf:"varint,10,opt,name=timeout_watchdog_height,json=timeoutWatchdogHeight,proto3" json:"timeout_watchdog_height,omitempty"`
+	// next_recovered_funds_claim_id is the id of the next recovered funds claim,
+	// zero if no claim was created yet. It must be greater than the ids of the
+	// recovered_funds_claims.
+	NextRecoveredFundsClaimId uint64 `protobuf:"varint,11,opt,name=next_recovered_funds_claim_id,json=nextRecoveredFundsClaimId,proto3" json:"next_recovered_funds_claim_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,16 +169,116 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetInFlightPackets() map[string]InFlightPacket {
+func (m *GenesisState) GetInFlightPackets() []InFlightPacketEntry {
 	if m != nil {
 		return m.InFlightPackets
 	}
 	return nil
 }
 
+func (m *GenesisState) GetRecoveredFundsClaims() []RecoveredFundsClaim {
+	if m != nil {
+		return m.RecoveredFundsClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimDelegates() []ClaimDelegate {
+	if m != nil {
+		return m.ClaimDelegates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitFlows() []RateLimitFlowEntry {
+	if m != nil {
+		return m.RateLimitFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForceRefundedPackets() []ForceRefundedPacket {
+	if m != nil {
+		return m.ForceRefundedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSplitForwardResults() []SplitForwardResultEntry {
+	if m != nil {
+		return m.SplitForwardResults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimeoutWatchdogTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutWatchdogTimestamp
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTimeoutWatchdogHeight() uint64 {
+	if m != nil {
+		return m.TimeoutWatchdogHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextRecoveredFundsClaimId() uint64 {
+	if m != nil {
+		return m.NextRecoveredFundsClaimId
+	}
+	return 0
+}
+
 // Params defines the set of packetforward parameters.
 type Params struct {
 	FeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_percentage,json=feePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percentage"`
+	// allowed_routes, if not empty, are the only routes packets may be
+	// forwarded along.
+	AllowedRoutes []ForwardRoute `protobuf:"bytes,2,rep,name=allowed_routes,json=allowedRoutes,proto3" json:"allowed_routes"`
+	// denied_routes are routes packets may never be forwarded along. They take
+	// precedence over allowed_routes.
+	DeniedRoutes []ForwardRoute `protobuf:"bytes,3,rep,name=denied_routes,json=deniedRoutes,proto3" json:"denied_routes"`
+	// fee_overrides replace fee_percentage for packets forwarded on an outbound
+	// channel and/or of a denom. The most specific matching override applies,
+	// with a matching channel taking precedence over a matching denom.
+	FeeOverrides []FeeOverride `protobuf:"bytes,4,rep,name=fee_overrides,json=feeOverrides,proto3" json:"fee_overrides"`
+	// fee_recipient receives the fees taken from forwarded packets. It may be a
+	// bech32 account address or the name of an existing module account. If
+	// empty, fees are sent to the community pool.
+	FeeRecipient string `protobuf:"bytes,5,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// rate_limits cap the amount of a denom that may be forwarded on an
+	// outbound channel within a period.
+	RateLimits []RateLimit `protobuf:"bytes,6,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// retry_backoff is the backoff policy applied to the timeout of retried
+	// forwards that do not specify one in their forward metadata.
+	RetryBackoff RetryBackoff `protobuf:"bytes,7,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff"`
+	// max_forward_depth is the maximum number of hops a received packet may be
+	// forwarded through, counting this chain and the forwards nested in next.
+	// Zero disables the limit.
+	MaxForwardDepth uint32 `protobuf:"varint,8,opt,name=max_forward_depth,json=maxForwardDepth,proto3" json:"max_forward_depth,omitempty"`
+	// max_memo_size is the maximum size in bytes of the memo of a received
+	// packet that is forwarded. Zero disables the limit.
+	MaxMemoSize uint32 `protobuf:"varint,9,opt,name=max_memo_size,json=maxMemoSize,proto3" json:"max_memo_size,omitempty"`
+	// loop_detection defines how forwards that would return tokens to a chain
+	// they already passed through are handled.
+	LoopDetection LoopDetection `protobuf:"varint,10,opt,name=loop_detection,json=loopDetection,proto3,enum=packetforward.v1.LoopDetection" json:"loop_detection,omitempty"`
+	// claimable_recovery, if set, holds the funds of failed nonrefundable
+	// forwards in a claims escrow account until they are claimed on behalf of
+	// the original sender, instead of moving them to the recovery address.
+	ClaimableRecovery bool `protobuf:"varint,11,opt,name=claimable_recovery,json=claimableRecovery,proto3" json:"claimable_recovery,omitempty"`
+	// relayer_incentives carve part of the forward fee out of packets forwarded
+	// on an outbound channel and escrow it as an ICS-29 relayer fee for the
+	// forwarded packet.
+	RelayerIncentives []RelayerIncentive `protobuf:"bytes,12,rep,name=relayer_incentives,json=relayerIncentives,proto3" json:"relayer_incentives"`
+	// timeouts, if set, replace the retries and timeouts the middleware was
+	// constructed with.
+	Timeouts *Timeouts `protobuf:"bytes,13,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	// timeout_watchdog, if set, alerts of in-flight packets whose inbound packet
+	// is about to time out while the forward is still pending.
+	TimeoutWatchdog *TimeoutWatchdog `protobuf:"bytes,14,opt,name=timeout_watchdog,json=timeoutWatchdog,proto3" json:"timeout_watchdog,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -120,488 +314,6556 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-// InFlightPacket contains information about original packet for
-// writing the acknowledgement and refunding if necessary.
-type InFlightPacket struct {
-	OriginalSenderAddress  string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
-	RefundChannelId        string `protobuf:"bytes,2,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
-	RefundPortId           string `protobuf:"bytes,3,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
-	PacketSrcChannelId     string `protobuf:"bytes,4,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
-	PacketSrcPortId        string `protobuf:"bytes,5,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
-	PacketTimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
-	PacketTimeoutHeight    string `protobuf:"bytes,7,opt,name=packet_timeout_height,json=packetTimeoutHeight,proto3" json:"packet_timeout_height,omitempty"`
-	PacketData             []byte `protobuf:"bytes,8,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
-	RefundSequence         uint64 `protobuf:"varint,9,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
-	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
-	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
-}
-
-func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
-func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
-func (*InFlightPacket) ProtoMessage()    {}
-func (*InFlightPacket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_afd4e56ea31af982, []int{2}
-}
-func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Params) GetAllowedRoutes() []ForwardRoute {
+	if m != nil {
+		return m.AllowedRoutes
 	}
+	return nil
 }
-func (m *InFlightPacket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InFlightPacket.Merge(m, src)
-}
-func (m *InFlightPacket) XXX_Size() int {
-	return m.Size()
-}
-func (m *InFlightPacket) XXX_DiscardUnknown() {
-	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
 
-func (m *InFlightPacket) GetOriginalSenderAddress() string {
+func (m *Params) GetDeniedRoutes() []ForwardRoute {
 	if m != nil {
-		return m.OriginalSenderAddress
+		return m.DeniedRoutes
 	}
-	return ""
+	return nil
 }
 
-func (m *InFlightPacket) GetRefundChannelId() string {
+func (m *Params) GetFeeOverrides() []FeeOverride {
 	if m != nil {
-		return m.RefundChannelId
+		return m.FeeOverrides
 	}
-	return ""
+	return nil
 }
 
-func (m *InFlightPacket) GetRefundPortId() string {
+func (m *Params) GetFeeRecipient() string {
 	if m != nil {
-		return m.RefundPortId
+		return m.FeeRecipient
 	}
 	return ""
 }
 
-func (m *InFlightPacket) GetPacketSrcChannelId() string {
+func (m *Params) GetRateLimits() []RateLimit {
 	if m != nil {
-		return m.PacketSrcChannelId
+		return m.RateLimits
 	}
-	return ""
+	return nil
 }
 
-func (m *InFlightPacket) GetPacketSrcPortId() string {
+func (m *Params) GetRetryBackoff() RetryBackoff {
 	if m != nil {
-		return m.PacketSrcPortId
+		return m.RetryBackoff
 	}
-	return ""
+	return RetryBackoff{}
 }
 
-func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+func (m *Params) GetMaxForwardDepth() uint32 {
 	if m != nil {
-		return m.PacketTimeoutTimestamp
+		return m.MaxForwardDepth
 	}
 	return 0
 }
 
-func (m *InFlightPacket) GetPacketTimeoutHeight() string {
+func (m *Params) GetMaxMemoSize() uint32 {
 	if m != nil {
-		return m.PacketTimeoutHeight
+		return m.MaxMemoSize
 	}
-	return ""
+	return 0
 }
 
-func (m *InFlightPacket) GetPacketData() []byte {
+func (m *Params) GetLoopDetection() LoopDetection {
 	if m != nil {
-		return m.PacketData
+		return m.LoopDetection
 	}
-	return nil
+	return LoopDetectionAllow
 }
 
-func (m *InFlightPacket) GetRefundSequence() uint64 {
+func (m *Params) GetClaimableRecovery() bool {
 	if m != nil {
-		return m.RefundSequence
+		return m.ClaimableRecovery
 	}
-	return 0
+	return false
 }
 
-func (m *InFlightPacket) GetRetriesRemaining() int32 {
+func (m *Params) GetRelayerIncentives() []RelayerIncentive {
 	if m != nil {
-		return m.RetriesRemaining
+		return m.RelayerIncentives
 	}
-	return 0
+	return nil
 }
 
-func (m *InFlightPacket) GetTimeout() uint64 {
+func (m *Params) GetTimeouts() *Timeouts {
 	if m != nil {
-		return m.Timeout
+		return m.Timeouts
 	}
-	return 0
+	return nil
 }
 
-func (m *InFlightPacket) GetNonrefundable() bool {
+func (m *Params) GetTimeoutWatchdog() *TimeoutWatchdog {
 	if m != nil {
-		return m.Nonrefundable
+		return m.TimeoutWatchdog
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "packetforward.v1.GenesisState")
-	proto.RegisterMapType((map[string]InFlightPacket)(nil), "packetforward.v1.GenesisState.InFlightPacketsEntry")
-	proto.RegisterType((*Params)(nil), "packetforward.v1.Params")
-	proto.RegisterType((*InFlightPacket)(nil), "packetforward.v1.InFlightPacket")
+// Timeouts defines the retries and timeouts of the packets the middleware
+// sends.
+type Timeouts struct {
+	// retries_on_timeout is the number of times a forward that timed out is
+	// retried if its forward metadata does not set retries.
+	RetriesOnTimeout uint32 `protobuf:"varint,1,opt,name=retries_on_timeout,json=retriesOnTimeout,proto3" json:"retries_on_timeout,omitempty"`
+	// forward_timeout is the timeout of forwarded packets whose forward metadata
+	// does not set one.
+	ForwardTimeout time.Duration `protobuf:"bytes,2,opt,name=forward_timeout,json=forwardTimeout,proto3,stdduration" json:"forward_timeout"`
+	// refund_timeout is the timeout of the packets this chain sends to refund
+	// the funds of failed forwards to the original sender.
+	RefundTimeout time.Duration `protobuf:"bytes,3,opt,name=refund_timeout,json=refundTimeout,proto3,stdduration" json:"refund_timeout"`
 }
 
-func init() { proto.RegisterFile("packetforward/v1/genesis.proto", fileDescriptor_afd4e56ea31af982) }
-
-var fileDescriptor_afd4e56ea31af982 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x4b, 0x1b, 0x4d,
-	0x1c, 0xcf, 0xc6, 0x18, 0x75, 0x12, 0xdf, 0xe6, 0xd1, 0xe7, 0x59, 0x7c, 0x20, 0x59, 0x82, 0xd0,
-	0xa0, 0xb8, 0x8b, 0x0a, 0x22, 0xde, 0x9a, 0xda, 0x17, 0xa1, 0xd0, 0xb0, 0x91, 0x42, 0x7b, 0x59,
-	0x26, 0xbb, 0xff, 0x6c, 0x06, 0xb3, 0x33, 0xdb, 0x99, 0x49, 0x24, 0xc7, 0x7e, 0x83, 0x7e, 0x83,
-	0x5e, 0x7b, 0xf4, 0x63, 0x78, 0xf4, 0x58, 0x7a, 0x90, 0xa2, 0x87, 0xde, 0xfb, 0x09, 0x4a, 0x66,
-	0x36, 0x36, 0x5b, 0x7b, 0x49, 0x66, 0x7f, 0x6f, 0xff, 0x17, 0x86, 0x41, 0xb5, 0x94, 0x84, 0x17,
-	0xa0, 0x7a, 0x5c, 0x5c, 0x12, 0x11, 0x79, 0xa3, 0x7d, 0x2f, 0x06, 0x06, 0x92, 0x4a, 0x37, 0x15,
-	0x5c, 0x71, 0xbc, 0x96, 0xe3, 0xdd, 0xd1, 0xfe, 0xd6, 0x46, 0xcc, 0x63, 0xae, 0x49, 0x6f, 0x72,
-	0x32, 0xba, 0xad, 0x75, 0x92, 0x50, 0xc6, 0x3d, 0xfd, 0x6b, 0xa0, 0xc6, 0x55, 0x11, 0x55, 0x5f,
-	0x9a, 0xb0, 0x8e, 0x22, 0x0a, 0xf0, 0x11, 0x2a, 0xa7, 0x44, 0x90, 0x44, 0xda, 0x96, 0x63, 0x35,
-	0x2b, 0x07, 0xb6, 0xfb, 0x67, 0xb8, 0xdb, 0xd6, 0x7c, 0xab, 0x74, 0x7d, 0x5b, 0x2f, 0xf8, 0x99,
-	0x1a, 0x7f, 0xb4, 0xd0, 0x3a, 0x65, 0x41, 0x6f, 0x40, 0xe3, 0xbe, 0x0a, 0x8c, 0x47, 0xda, 0x45,
-	0x67, 0xae, 0x59, 0x39, 0x38, 0x7c, 0x9c, 0x31, 0x5b, 0xd3, 0x3d, 0x63, 0x2f, 0xb4, 0xad, 0x6d,
-	0x5c, 0xcf, 0x99, 0x12, 0xe3, 0x96, 0x33, 0x89, 0xff, 0x79, 0x5b, 0xb7, 0xc7, 0x24, 0x19, 0x9c,
-	0x34, 0x1e, 0x65, 0x37, 0xfc, 0x55, 0x9a, 0xf7, 0x6d, 0x45, 0x68, 0xe3, 0x6f, 0x51, 0x78, 0x0d,
-	0xcd, 0x5d, 0xc0, 0x58, 0x0f, 0xb4, 0xe4, 0x4f, 0x8e, 0xf8, 0x08, 0xcd, 0x8f, 0xc8, 0x60, 0x08,
-	0x76, 0x51, 0x0f, 0xe9, 0x3c, 0x6e, 0x30, 0x1f, 0xe4, 0x1b, 0xf9, 0x49, 0xf1, 0xd8, 0x6a, 0xbc,
-	0x43, 0x65, 0xb3, 0x01, 0xfc, 0x06, 0xad, 0xf4, 0x00, 0x82, 0x14, 0x44, 0x08, 0x4c, 0x91, 0x18,
-	0x4c, 0x89, 0x56, 0x73, 0xd2, 0xfa, 0xb7, 0xdb, 0xfa, 0xff, 0x21, 0x97, 0x09, 0x97, 0x32, 0xba,
-	0x70, 0x29, 0xf7, 0x12, 0xa2, 0xfa, 0xee, 0x6b, 0x88, 0x49, 0x38, 0x3e, 0x85, 0xf0, 0xcb, 0x8f,
-	0xab, 0x1d, 0xcb, 0x5f, 0xee, 0x01, 0xb4, 0x1f, 0xec, 0x8d, 0xcf, 0x25, 0xb4, 0x92, 0x2f, 0x8c,
-	0x8f, 0xd0, 0x7f, 0x5c, 0xd0, 0x98, 0x32, 0x32, 0x08, 0x24, 0xb0, 0x08, 0x44, 0x40, 0xa2, 0x48,
-	0x80, 0x94, 0xd9, 0x3c, 0x9b, 0x53, 0xba, 0xa3, 0xd9, 0xa7, 0x86, 0xc4, 0x3b, 0x68, 0x5d, 0x40,
-	0x6f, 0xc8, 0xa2, 0x20, 0xec, 0x13, 0xc6, 0x60, 0x10, 0xd0, 0x48, 0x4f, 0xbb, 0xe4, 0xaf, 0x1a,
-	0xe2, 0x99, 0xc1, 0xcf, 0x22, 0xbc, 0x8d, 0x56, 0x32, 0x6d, 0xca, 0x85, 0x9a, 0x08, 0xe7, 0xb4,
-	0xb0, 0x6a, 0xd0, 0x36, 0x17, 0xea, 0x2c, 0xc2, 0xfb, 0x68, 0xd3, 0x6c, 0x29, 0x90, 0x22, 0x9c,
-	0x4d, 0x2d, 0x69, 0x31, 0x36, 0x64, 0x47, 0x84, 0xbf, 0x83, 0x77, 0x11, 0x9e, 0xb1, 0x4c, 0xc3,
-	0xe7, 0x4d, 0x17, 0x0f, 0xfa, 0x2c, 0xff, 0x18, 0xd9, 0x99, 0x58, 0xd1, 0x04, 0xf8, 0xd0, 0xfc,
-	0x4b, 0x45, 0x92, 0xd4, 0x2e, 0x3b, 0x56, 0xb3, 0xe4, 0xff, 0x6b, 0xf8, 0x73, 0x43, 0x9f, 0x4f,
-	0x59, 0x7c, 0xf0, 0xd0, 0xd9, 0xd4, 0xd9, 0x87, 0xc9, 0x0a, 0xed, 0x05, 0x5d, 0xe9, 0x9f, 0x9c,
-	0xed, 0x95, 0xa6, 0x70, 0x1d, 0x55, 0x32, 0x4f, 0x44, 0x14, 0xb1, 0x17, 0x1d, 0xab, 0x59, 0xf5,
-	0x91, 0x81, 0x4e, 0x89, 0x22, 0xf8, 0x09, 0xca, 0xf6, 0x14, 0x48, 0xf8, 0x30, 0x04, 0x16, 0x82,
-	0xbd, 0xa4, 0xbb, 0xc8, 0x76, 0xd5, 0xc9, 0x50, 0xbc, 0x3b, 0xd9, 0xb4, 0x12, 0x14, 0x64, 0x20,
-	0x20, 0x21, 0x94, 0x51, 0x16, 0xdb, 0xc8, 0xb1, 0x9a, 0xf3, 0xfe, 0x5a, 0x46, 0xf8, 0x53, 0x1c,
-	0xdb, 0x68, 0x21, 0xeb, 0xd1, 0xae, 0xe8, 0xb4, 0xe9, 0x27, 0xde, 0x46, 0xcb, 0x8c, 0x33, 0x93,
-	0x4d, 0xba, 0x03, 0xb0, 0xab, 0x8e, 0xd5, 0x5c, 0xf4, 0xf3, 0x60, 0x2b, 0xbd, 0xbe, 0xab, 0x59,
-	0x37, 0x77, 0x35, 0xeb, 0xfb, 0x5d, 0xcd, 0xfa, 0x74, 0x5f, 0x2b, 0xdc, 0xdc, 0xd7, 0x0a, 0x5f,
-	0xef, 0x6b, 0x85, 0xf7, 0x6f, 0x63, 0xaa, 0xfa, 0xc3, 0xae, 0x1b, 0xf2, 0xc4, 0x33, 0xf7, 0xce,
-	0xa3, 0xdd, 0x70, 0x8f, 0xa4, 0xa9, 0xf4, 0x12, 0x1a, 0x45, 0x03, 0xb8, 0x24, 0x02, 0x3c, 0x33,
-	0xe1, 0x5e, 0x76, 0xd3, 0xf7, 0x66, 0x98, 0xd1, 0xb1, 0x97, 0x7f, 0x67, 0xd4, 0x38, 0x05, 0xd9,
-	0x2d, 0xeb, 0x87, 0xe2, 0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0xde, 0x27, 0xed, 0x85,
-	0x04, 0x00, 0x00,
+func (m *Timeouts) Reset()         { *m = Timeouts{} }
+func (m *Timeouts) String() string { return proto.CompactTextString(m) }
+func (*Timeouts) ProtoMessage()    {}
+func (*Timeouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{2}
+}
+func (m *Timeouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Timeouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Timeouts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Timeouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timeouts.Merge(m, src)
+}
+func (m *Timeouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *Timeouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timeouts.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Timeouts proto.InternalMessageInfo
+
+func (m *Timeouts) GetRetriesOnTimeout() uint32 {
+	if m != nil {
+		return m.RetriesOnTimeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Timeouts) GetForwardTimeout() time.Duration {
+	if m != nil {
+		return m.ForwardTimeout
+	}
+	return 0
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InFlightPackets) > 0 {
-		for k := range m.InFlightPackets {
-			v := m.InFlightPackets[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Timeouts) GetRefundTimeout() time.Duration {
+	if m != nil {
+		return m.RefundTimeout
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// TimeoutWatchdog defines when an alert is emitted for an in-flight packet
+// whose inbound packet is about to time out. The source chain cannot time out
+// an inbound packet this chain received, but the original sender waits on its
+// acknowledgement for as long as the forward is pending.
+type TimeoutWatchdog struct {
+	// window is how long before the timeout timestamp of the inbound packet the
+	// alert is emitted.
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// height_window is how many blocks before the timeout height of the inbound
+	// packet the alert is emitted.
+	HeightWindow uint64 `protobuf:"varint,2,opt,name=height_window,json=heightWindow,proto3" json:"height_window,omitempty"`
+}
+
+func (m *TimeoutWatchdog) Reset()         { *m = TimeoutWatchdog{} }
+func (m *TimeoutWatchdog) String() string { return proto.CompactTextString(m) }
+func (*TimeoutWatchdog) ProtoMessage()    {}
+func (*TimeoutWatchdog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{3}
+}
+func (m *TimeoutWatchdog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutWatchdog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutWatchdog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TimeoutWatchdog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutWatchdog.Merge(m, src)
+}
+func (m *TimeoutWatchdog) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutWatchdog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutWatchdog.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TimeoutWatchdog proto.InternalMessageInfo
+
+func (m *TimeoutWatchdog) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FeePercentage.Size()
-		i -= size
-		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TimeoutWatchdog) GetHeightWindow() uint64 {
+	if m != nil {
+		return m.HeightWindow
+	}
+	return 0
+}
+
+// RetryBackoff defines how the timeout of a forward grows with each retry.
+type RetryBackoff struct {
+	Strategy BackoffStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=packetforward.v1.BackoffStrategy" json:"strategy,omitempty"`
+	// max_timeout caps the timeout of a retry, zero for no cap.
+	MaxTimeout time.Duration `protobuf:"bytes,2,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout"`
+}
+
+func (m *RetryBackoff) Reset()         { *m = RetryBackoff{} }
+func (m *RetryBackoff) String() string { return proto.CompactTextString(m) }
+func (*RetryBackoff) ProtoMessage()    {}
+func (*RetryBackoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{4}
+}
+func (m *RetryBackoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryBackoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryBackoff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RetryBackoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryBackoff.Merge(m, src)
+}
+func (m *RetryBackoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryBackoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryBackoff.DiscardUnknown(m)
 }
 
-func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RetryBackoff proto.InternalMessageInfo
+
+func (m *RetryBackoff) GetStrategy() BackoffStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	return dAtA[:n], nil
+	return BackoffNone
 }
 
-func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RetryBackoff) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
 }
 
-func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonrefundable {
-		i--
-		if m.Nonrefundable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// ForwardRoute identifies forwarding packets received on an inbound channel
+// to an outbound channel of this chain. Either channel may be "*" to match any
+// channel.
+type ForwardRoute struct {
+	// inbound_channel_id is the channel on this chain packets are received on.
+	InboundChannelId string `protobuf:"bytes,1,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	// outbound_channel_id is the channel on this chain packets are forwarded on.
+	OutboundChannelId string `protobuf:"bytes,2,opt,name=outbound_channel_id,json=outboundChannelId,proto3" json:"outbound_channel_id,omitempty"`
+}
+
+func (m *ForwardRoute) Reset()         { *m = ForwardRoute{} }
+func (m *ForwardRoute) String() string { return proto.CompactTextString(m) }
+func (*ForwardRoute) ProtoMessage()    {}
+func (*ForwardRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{5}
+}
+func (m *ForwardRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.RetriesRemaining != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.RefundSequence != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *ForwardRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRoute.Merge(m, src)
+}
+func (m *ForwardRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRoute proto.InternalMessageInfo
+
+func (m *ForwardRoute) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
 	}
-	if len(m.PacketData) > 0 {
-		i -= len(m.PacketData)
-		copy(dAtA[i:], m.PacketData)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *ForwardRoute) GetOutboundChannelId() string {
+	if m != nil {
+		return m.OutboundChannelId
 	}
-	if len(m.PacketTimeoutHeight) > 0 {
-		i -= len(m.PacketTimeoutHeight)
-		copy(dAtA[i:], m.PacketTimeoutHeight)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+// FeeOverride defines the fee taken from packets forwarded on an outbound
+// channel and/or of a denom.
+type FeeOverride struct {
+	// channel_id is the outbound channel the override applies to, or "*" for any
+	// channel.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom on this chain the override applies to, or "*" for any
+	// denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee_percentage is the percentage of the forwarded amount taken as a fee.
+	FeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_percentage,json=feePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percentage"`
+	// min_fee is the minimum fee amount taken, zero for none. It may only be set
+	// for a specific denom.
+	MinFee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee"`
+	// max_fee is the maximum fee amount taken, zero for none. It may only be set
+	// for a specific denom.
+	MaxFee cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
+}
+
+func (m *FeeOverride) Reset()         { *m = FeeOverride{} }
+func (m *FeeOverride) String() string { return proto.CompactTextString(m) }
+func (*FeeOverride) ProtoMessage()    {}
+func (*FeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{6}
+}
+func (m *FeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PacketTimeoutTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *FeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeOverride.Merge(m, src)
+}
+func (m *FeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeOverride proto.InternalMessageInfo
+
+func (m *FeeOverride) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.PacketSrcPortId) > 0 {
-		i -= len(m.PacketSrcPortId)
-		copy(dAtA[i:], m.PacketSrcPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *FeeOverride) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.PacketSrcChannelId) > 0 {
-		i -= len(m.PacketSrcChannelId)
-		copy(dAtA[i:], m.PacketSrcChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+// RelayerIncentive defines the shares of the forward fee escrowed as ICS-29
+// relayer fees for the packets forwarded on an outbound channel. The rest of
+// the forward fee is paid to the fee recipient as usual.
+type RelayerIncentive struct {
+	// channel_id is the outbound channel the incentive applies to. It must be
+	// fee enabled for the incentive to be escrowed.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// recv_fee_share is the share of the forward fee paid to the relayer of the
+	// forwarded packet.
+	RecvFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=recv_fee_share,json=recvFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recv_fee_share"`
+	// ack_fee_share is the share of the forward fee paid to the relayer of the
+	// acknowledgement of the forwarded packet.
+	AckFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ack_fee_share,json=ackFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ack_fee_share"`
+	// timeout_fee_share is the share of the forward fee paid to the relayer of
+	// the timeout of the forwarded packet.
+	TimeoutFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=timeout_fee_share,json=timeoutFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_fee_share"`
+	// refund_address receives the escrowed fees that are not paid to relayers,
+	// e.g. the timeout fee of a packet that was acknowledged. It must be an
+	// account that may escrow ICS-29 fees, i.e. not a module account.
+	RefundAddress string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *RelayerIncentive) Reset()         { *m = RelayerIncentive{} }
+func (m *RelayerIncentive) String() string { return proto.CompactTextString(m) }
+func (*RelayerIncentive) ProtoMessage()    {}
+func (*RelayerIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{7}
+}
+func (m *RelayerIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RefundPortId) > 0 {
-		i -= len(m.RefundPortId)
-		copy(dAtA[i:], m.RefundPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *RelayerIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerIncentive.Merge(m, src)
+}
+func (m *RelayerIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerIncentive proto.InternalMessageInfo
+
+func (m *RelayerIncentive) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.RefundChannelId) > 0 {
-		i -= len(m.RefundChannelId)
-		copy(dAtA[i:], m.RefundChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *RelayerIncentive) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
 	}
-	if len(m.OriginalSenderAddress) > 0 {
-		i -= len(m.OriginalSenderAddress)
-		copy(dAtA[i:], m.OriginalSenderAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// RateLimit defines the maximum amount of a denom that may be forwarded on an
+// outbound channel within a period.
+type RateLimit struct {
+	// channel_id is the outbound channel the rate limit applies to.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom on this chain the rate limit applies to.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_amount is the maximum amount that may be forwarded within a period.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// period is the length of the window the forwarded amount is tracked over.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{8}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.InFlightPackets) > 0 {
-		for k, v := range m.InFlightPackets {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
-			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
-		}
+	return ""
+}
+
+func (m *RateLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	return n
+	return 0
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+// RateLimitFlow tracks the amount forwarded for a rate limit in the current
+// window.
+type RateLimitFlow struct {
+	// window_start is the block time the current window started at.
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// amount is the amount forwarded since window_start.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{9}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// RateLimitCharge records the amount a forward counted against a rate limit,
+// so that it can be given back if the forward is refunded.
+type RateLimitCharge struct {
+	// channel_id is the outbound channel of the rate limit.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom on this chain of the rate limit.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount counted.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// window_start is the start of the window the amount was counted in.
+	WindowStart time.Time `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+}
+
+func (m *RateLimitCharge) Reset()         { *m = RateLimitCharge{} }
+func (m *RateLimitCharge) String() string { return proto.CompactTextString(m) }
+func (*RateLimitCharge) ProtoMessage()    {}
+func (*RateLimitCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{10}
+}
+func (m *RateLimitCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitCharge.Merge(m, src)
+}
+func (m *RateLimitCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitCharge proto.InternalMessageInfo
+
+func (m *RateLimitCharge) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitCharge) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitCharge) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// InFlightPacket contains information about original packet for
+// writing the acknowledgement and refunding if necessary.
+type InFlightPacket struct {
+	OriginalSenderAddress  string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	RefundChannelId        string `protobuf:"bytes,2,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	RefundPortId           string `protobuf:"bytes,3,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	PacketSrcChannelId     string `protobuf:"bytes,4,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
+	PacketSrcPortId        string `protobuf:"bytes,5,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
+	PacketTimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
+	PacketTimeoutHeight    string `protobuf:"bytes,7,opt,name=packet_timeout_height,json=packetTimeoutHeight,proto3" json:"packet_timeout_height,omitempty"`
+	PacketData             []byte `protobuf:"bytes,8,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	RefundSequence         uint64 `protobuf:"varint,9,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+	// retry_backoff is the backoff policy applied to the timeout of retries.
+	RetryBackoff RetryBackoff `protobuf:"bytes,13,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff"`
+	// retries_attempted is the number of times the forward has been retried.
+	RetriesAttempted uint32 `protobuf:"varint,14,opt,name=retries_attempted,json=retriesAttempted,proto3" json:"retries_attempted,omitempty"`
+	// denom is the denom on this chain of the forwarded packet.
+	Denom string `protobuf:"bytes,15,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the forwarded packet, after fees.
+	Amount string `protobuf:"bytes,16,opt,name=amount,proto3" json:"amount,omitempty"`
+	// recover_address is the address set in the forward metadata to receive
+	// the funds of a nonrefundable forward that failed.
+	RecoverAddress string `protobuf:"bytes,17,opt,name=recover_address,json=recoverAddress,proto3" json:"recover_address,omitempty"`
+	// rate_limit_charge is the amount the forward counted against a rate limit,
+	// if any.
+	RateLimitCharge *RateLimitCharge `protobuf:"bytes,18,opt,name=rate_limit_charge,json=rateLimitCharge,proto3" json:"rate_limit_charge,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{11}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcChannelId() string {
+	if m != nil {
+		return m.PacketSrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcPortId() string {
+	if m != nil {
+		return m.PacketSrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.PacketTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketTimeoutHeight() string {
+	if m != nil {
+		return m.PacketTimeoutHeight
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() int32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetNonrefundable() bool {
+	if m != nil {
+		return m.Nonrefundable
+	}
+	return false
+}
+
+func (m *InFlightPacket) GetRetryBackoff() RetryBackoff {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return RetryBackoff{}
+}
+
+func (m *InFlightPacket) GetRetriesAttempted() uint32 {
+	if m != nil {
+		return m.RetriesAttempted
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRecoverAddress() string {
+	if m != nil {
+		return m.RecoverAddress
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRateLimitCharge() *RateLimitCharge {
+	if m != nil {
+		return m.RateLimitCharge
+	}
+	return nil
+}
+
+// SplitForwardResult records the outcome of one forward of an inbound packet
+// split across several forwards, until all of them have completed.
+type SplitForwardResult struct {
+	// channel_id is the channel the packet was forwarded on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded on.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// denom is the full denom path of the forwarded packet.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the forwarded packet.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// success is true if the forwarded packet was acknowledged successfully.
+	Success bool `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the error the forwarded packet failed with, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// handler is the forward handler the split was delivered to on this chain,
+	// if any. Such results have no channel or port, and sequence holds the index
+	// of the split instead.
+	Handler string `protobuf:"bytes,8,opt,name=handler,proto3" json:"handler,omitempty"`
+	// rate_limit_charge is the amount the forward counted against a rate limit,
+	// if any.
+	RateLimitCharge *RateLimitCharge `protobuf:"bytes,9,opt,name=rate_limit_charge,json=rateLimitCharge,proto3" json:"rate_limit_charge,omitempty"`
+}
+
+func (m *SplitForwardResult) Reset()         { *m = SplitForwardResult{} }
+func (m *SplitForwardResult) String() string { return proto.CompactTextString(m) }
+func (*SplitForwardResult) ProtoMessage()    {}
+func (*SplitForwardResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{12}
+}
+func (m *SplitForwardResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitForwardResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitForwardResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitForwardResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitForwardResult.Merge(m, src)
+}
+func (m *SplitForwardResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitForwardResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitForwardResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitForwardResult proto.InternalMessageInfo
+
+func (m *SplitForwardResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SplitForwardResult) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *SplitForwardResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *SplitForwardResult) GetRateLimitCharge() *RateLimitCharge {
+	if m != nil {
+		return m.RateLimitCharge
+	}
+	return nil
+}
+
+// ClaimDelegate is the account approved to claim the recovered funds of an
+// original sender.
+type ClaimDelegate struct {
+	OriginalSenderAddress string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	Delegate              string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *ClaimDelegate) Reset()         { *m = ClaimDelegate{} }
+func (m *ClaimDelegate) String() string { return proto.CompactTextString(m) }
+func (*ClaimDelegate) ProtoMessage()    {}
+func (*ClaimDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{13}
+}
+func (m *ClaimDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimDelegate.Merge(m, src)
+}
+func (m *ClaimDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimDelegate proto.InternalMessageInfo
+
+func (m *ClaimDelegate) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *ClaimDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// InFlightPacketEntry pairs an InFlightPacket with the channel, port and
+// sequence of the forwarded packet it is stored under.
+type InFlightPacketEntry struct {
+	ChannelId      string         `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId         string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Sequence       uint64         `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	InFlightPacket InFlightPacket `protobuf:"bytes,4,opt,name=in_flight_packet,json=inFlightPacket,proto3" json:"in_flight_packet"`
+}
+
+func (m *InFlightPacketEntry) Reset()         { *m = InFlightPacketEntry{} }
+func (m *InFlightPacketEntry) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacketEntry) ProtoMessage()    {}
+func (*InFlightPacketEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{14}
+}
+func (m *InFlightPacketEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacketEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacketEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacketEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacketEntry.Merge(m, src)
+}
+func (m *InFlightPacketEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacketEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacketEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacketEntry proto.InternalMessageInfo
+
+func (m *InFlightPacketEntry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacketEntry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightPacketEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacketEntry) GetInFlightPacket() InFlightPacket {
+	if m != nil {
+		return m.InFlightPacket
+	}
+	return InFlightPacket{}
+}
+
+// RateLimitFlowEntry pairs a RateLimitFlow with the outbound channel and denom
+// of the rate limit it tracks.
+type RateLimitFlowEntry struct {
+	ChannelId string        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Flow      RateLimitFlow `protobuf:"bytes,3,opt,name=flow,proto3" json:"flow"`
+}
+
+func (m *RateLimitFlowEntry) Reset()         { *m = RateLimitFlowEntry{} }
+func (m *RateLimitFlowEntry) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlowEntry) ProtoMessage()    {}
+func (*RateLimitFlowEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{15}
+}
+func (m *RateLimitFlowEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlowEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlowEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlowEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlowEntry.Merge(m, src)
+}
+func (m *RateLimitFlowEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlowEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlowEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlowEntry proto.InternalMessageInfo
+
+func (m *RateLimitFlowEntry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitFlowEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitFlowEntry) GetFlow() RateLimitFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return RateLimitFlow{}
+}
+
+// ForceRefundedPacket identifies a forwarded packet whose inbound packet was
+// refunded by the authority, by its channel, port and sequence.
+type ForceRefundedPacket struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *ForceRefundedPacket) Reset()         { *m = ForceRefundedPacket{} }
+func (m *ForceRefundedPacket) String() string { return proto.CompactTextString(m) }
+func (*ForceRefundedPacket) ProtoMessage()    {}
+func (*ForceRefundedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{16}
+}
+func (m *ForceRefundedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceRefundedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceRefundedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceRefundedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceRefundedPacket.Merge(m, src)
+}
+func (m *ForceRefundedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceRefundedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceRefundedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceRefundedPacket proto.InternalMessageInfo
+
+func (m *ForceRefundedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ForceRefundedPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ForceRefundedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// SplitForwardResultEntry pairs a SplitForwardResult with the channel, port
+// and sequence of the inbound packet it was split from.
+type SplitForwardResultEntry struct {
+	InboundChannelId string             `protobuf:"bytes,1,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	InboundPortId    string             `protobuf:"bytes,2,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	InboundSequence  uint64             `protobuf:"varint,3,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	Result           SplitForwardResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result"`
+}
+
+func (m *SplitForwardResultEntry) Reset()         { *m = SplitForwardResultEntry{} }
+func (m *SplitForwardResultEntry) String() string { return proto.CompactTextString(m) }
+func (*SplitForwardResultEntry) ProtoMessage()    {}
+func (*SplitForwardResultEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{17}
+}
+func (m *SplitForwardResultEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitForwardResultEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitForwardResultEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitForwardResultEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitForwardResultEntry.Merge(m, src)
+}
+func (m *SplitForwardResultEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitForwardResultEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitForwardResultEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitForwardResultEntry proto.InternalMessageInfo
+
+func (m *SplitForwardResultEntry) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *SplitForwardResultEntry) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *SplitForwardResultEntry) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *SplitForwardResultEntry) GetResult() SplitForwardResult {
+	if m != nil {
+		return m.Result
+	}
+	return SplitForwardResult{}
+}
+
+// RecoveredFundsClaim holds the funds of a failed nonrefundable forward in the
+// claims escrow account until they are claimed on behalf of the original
+// sender.
+type RecoveredFundsClaim struct {
+	// id is the unique identifier of the claim.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// original_sender_address is the sender of the inbound packet the funds were
+	// forwarded for.
+	OriginalSenderAddress string `protobuf:"bytes,2,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	// amount is the amount held for the claim.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// inbound_port_id is the port on this chain the inbound packet was received on.
+	InboundPortId string `protobuf:"bytes,4,opt,name=inbound_port_id,json=inboundPortId,proto3" json:"inbound_port_id,omitempty"`
+	// inbound_channel_id is the channel on this chain the inbound packet was received on.
+	InboundChannelId string `protobuf:"bytes,5,opt,name=inbound_channel_id,json=inboundChannelId,proto3" json:"inbound_channel_id,omitempty"`
+	// inbound_sequence is the sequence of the inbound packet.
+	InboundSequence uint64 `protobuf:"varint,6,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	// error is the error the forwarded packet failed with.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RecoveredFundsClaim) Reset()         { *m = RecoveredFundsClaim{} }
+func (m *RecoveredFundsClaim) String() string { return proto.CompactTextString(m) }
+func (*RecoveredFundsClaim) ProtoMessage()    {}
+func (*RecoveredFundsClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{18}
+}
+func (m *RecoveredFundsClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveredFundsClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveredFundsClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveredFundsClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveredFundsClaim.Merge(m, src)
+}
+func (m *RecoveredFundsClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveredFundsClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveredFundsClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveredFundsClaim proto.InternalMessageInfo
+
+func (m *RecoveredFundsClaim) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecoveredFundsClaim) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *RecoveredFundsClaim) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RecoveredFundsClaim) GetInboundPortId() string {
+	if m != nil {
+		return m.InboundPortId
+	}
+	return ""
+}
+
+func (m *RecoveredFundsClaim) GetInboundChannelId() string {
+	if m != nil {
+		return m.InboundChannelId
+	}
+	return ""
+}
+
+func (m *RecoveredFundsClaim) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *RecoveredFundsClaim) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("packetforward.v1.LoopDetection", LoopDetection_name, LoopDetection_value)
+	proto.RegisterEnum("packetforward.v1.BackoffStrategy", BackoffStrategy_name, BackoffStrategy_value)
+	proto.RegisterType((*GenesisState)(nil), "packetforward.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "packetforward.v1.Params")
+	proto.RegisterType((*Timeouts)(nil), "packetforward.v1.Timeouts")
+	proto.RegisterType((*TimeoutWatchdog)(nil), "packetforward.v1.TimeoutWatchdog")
+	proto.RegisterType((*RetryBackoff)(nil), "packetforward.v1.RetryBackoff")
+	proto.RegisterType((*ForwardRoute)(nil), "packetforward.v1.ForwardRoute")
+	proto.RegisterType((*FeeOverride)(nil), "packetforward.v1.FeeOverride")
+	proto.RegisterType((*RelayerIncentive)(nil), "packetforward.v1.RelayerIncentive")
+	proto.RegisterType((*RateLimit)(nil), "packetforward.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "packetforward.v1.RateLimitFlow")
+	proto.RegisterType((*RateLimitCharge)(nil), "packetforward.v1.RateLimitCharge")
+	proto.RegisterType((*InFlightPacket)(nil), "packetforward.v1.InFlightPacket")
+	proto.RegisterType((*SplitForwardResult)(nil), "packetforward.v1.SplitForwardResult")
+	proto.RegisterType((*ClaimDelegate)(nil), "packetforward.v1.ClaimDelegate")
+	proto.RegisterType((*InFlightPacketEntry)(nil), "packetforward.v1.InFlightPacketEntry")
+	proto.RegisterType((*RateLimitFlowEntry)(nil), "packetforward.v1.RateLimitFlowEntry")
+	proto.RegisterType((*ForceRefundedPacket)(nil), "packetforward.v1.ForceRefundedPacket")
+	proto.RegisterType((*SplitForwardResultEntry)(nil), "packetforward.v1.SplitForwardResultEntry")
+	proto.RegisterType((*RecoveredFundsClaim)(nil), "packetforward.v1.RecoveredFundsClaim")
+}
+
+func init() { proto.RegisterFile("packetforward/v1/genesis.proto", fileDescriptor_afd4e56ea31af982) }
+
+var fileDescriptor_afd4e56ea31af982 = []byte{
+	// 2162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xf7, 0x8c, 0xc7, 0x63, 0xfb, 0xd9, 0xf3, 0x55, 0x8e, 0x93, 0xde, 0x59, 0x62, 0x9b, 0x21,
+	0x0b, 0xd9, 0x2c, 0x99, 0x21, 0x8b, 0x08, 0x8b, 0x58, 0x24, 0xfc, 0x35, 0xd9, 0xd9, 0x9d, 0xb5,
+	0xad, 0x1e, 0x8b, 0x00, 0x97, 0x56, 0xb9, 0xbb, 0x66, 0xdc, 0x4a, 0x77, 0xd7, 0x50, 0x5d, 0xe3,
+	0x8f, 0xe5, 0x08, 0x07, 0x94, 0x0b, 0x2b, 0x21, 0x24, 0x2e, 0x39, 0x71, 0xe1, 0xb2, 0xc0, 0x1d,
+	0x71, 0x45, 0x7b, 0xe0, 0xb0, 0xe2, 0x84, 0x38, 0x04, 0x94, 0x1c, 0xf8, 0x37, 0x50, 0x7d, 0xf5,
+	0x7c, 0xb5, 0x13, 0xdb, 0x68, 0x2f, 0x96, 0xab, 0xde, 0xef, 0xfd, 0xba, 0xea, 0xd5, 0x7b, 0xaf,
+	0x5e, 0xbd, 0x81, 0xb5, 0x3e, 0x76, 0x9f, 0x10, 0xde, 0xa5, 0xec, 0x14, 0x33, 0xaf, 0x71, 0xf2,
+	0xa0, 0xd1, 0x23, 0x11, 0x89, 0xfd, 0xb8, 0xde, 0x67, 0x94, 0x53, 0x54, 0x1e, 0x93, 0xd7, 0x4f,
+	0x1e, 0x54, 0x6f, 0xf4, 0x68, 0x8f, 0x4a, 0x61, 0x43, 0xfc, 0xa7, 0x70, 0xd5, 0x0a, 0x0e, 0xfd,
+	0x88, 0x36, 0xe4, 0x5f, 0x3d, 0xb5, 0xe6, 0xd2, 0x38, 0xa4, 0x71, 0xe3, 0x08, 0xc7, 0xa4, 0x71,
+	0xf2, 0xe0, 0x88, 0x70, 0xfc, 0xa0, 0xe1, 0x52, 0x3f, 0x32, 0xf2, 0x1e, 0xa5, 0xbd, 0x80, 0x34,
+	0xe4, 0xe8, 0x68, 0xd0, 0x6d, 0x78, 0x03, 0x86, 0xb9, 0x4f, 0x8d, 0x7c, 0x7d, 0x52, 0xce, 0xfd,
+	0x90, 0xc4, 0x1c, 0x87, 0x7d, 0x05, 0xa8, 0xfd, 0x31, 0x0f, 0xcb, 0x8f, 0xd4, 0x6a, 0x3b, 0x1c,
+	0x73, 0x82, 0x1e, 0x42, 0xbe, 0x8f, 0x19, 0x0e, 0x63, 0x2b, 0xb3, 0x91, 0xb9, 0xbb, 0xf4, 0xae,
+	0x55, 0x9f, 0x5c, 0x7d, 0xfd, 0x40, 0xca, 0xb7, 0x72, 0x9f, 0x3f, 0x5f, 0x9f, 0xb1, 0x35, 0x1a,
+	0x3d, 0x86, 0x8a, 0x1f, 0x39, 0xdd, 0xc0, 0xef, 0x1d, 0x73, 0x47, 0xa9, 0xc4, 0xd6, 0xec, 0xc6,
+	0xec, 0xdd, 0xa5, 0x77, 0xdf, 0x9a, 0xa6, 0x68, 0x45, 0x4d, 0x89, 0x3c, 0x90, 0x82, 0xdd, 0x88,
+	0xb3, 0x73, 0xcd, 0x57, 0xf2, 0xc7, 0x44, 0x31, 0xc2, 0x70, 0x93, 0x11, 0x97, 0x9e, 0x10, 0x46,
+	0x3c, 0xa7, 0x3b, 0x88, 0xbc, 0xd8, 0x71, 0x03, 0xec, 0x87, 0xb1, 0x95, 0xbb, 0x88, 0xdd, 0x36,
+	0xf8, 0xa6, 0x80, 0x6f, 0x0b, 0xb4, 0x66, 0xbf, 0xc1, 0xa6, 0x45, 0x31, 0xda, 0x83, 0x92, 0xa4,
+	0x74, 0x3c, 0x12, 0x90, 0x1e, 0xe6, 0x24, 0xb6, 0xe6, 0x24, 0xf7, 0xfa, 0x34, 0xb7, 0x54, 0xd9,
+	0xd1, 0x38, 0xcd, 0x5a, 0x74, 0x47, 0x27, 0x63, 0x74, 0x08, 0x65, 0x86, 0x39, 0x71, 0x02, 0x3f,
+	0xf4, 0xb9, 0xd3, 0x0d, 0xe8, 0x69, 0x6c, 0xe5, 0x25, 0xe1, 0x9d, 0x94, 0xc5, 0x62, 0x4e, 0xda,
+	0x02, 0xd8, 0x0c, 0xe8, 0xe9, 0xa8, 0x25, 0x8a, 0x6c, 0x54, 0x22, 0x0d, 0xd1, 0xa5, 0xcc, 0x25,
+	0x0e, 0x23, 0xc2, 0x0c, 0xc4, 0x4b, 0xcc, 0x3c, 0x7f, 0x91, 0x21, 0x9a, 0x02, 0x6f, 0x6b, 0xb8,
+	0x32, 0xa8, 0x31, 0x44, 0x77, 0x5a, 0x14, 0x23, 0x17, 0x56, 0xe3, 0x7e, 0x20, 0xd6, 0xac, 0x38,
+	0x1c, 0x46, 0xe2, 0x41, 0xc0, 0x63, 0x6b, 0x41, 0x7e, 0xe1, 0xed, 0xe9, 0x2f, 0x74, 0x04, 0xbc,
+	0xa9, 0xc6, 0xb6, 0x04, 0x8f, 0x6e, 0x61, 0x25, 0x9e, 0x12, 0xc7, 0xe8, 0x7d, 0xa8, 0x0a, 0x2f,
+	0xa4, 0x03, 0xee, 0x9c, 0x62, 0xee, 0x1e, 0x7b, 0xb4, 0xe7, 0x24, 0x6e, 0x69, 0x2d, 0x6e, 0x64,
+	0xee, 0xe6, 0x6c, 0x4b, 0x23, 0x1e, 0x6b, 0xc0, 0xa1, 0x91, 0xa3, 0x87, 0x70, 0x6b, 0x4a, 0xfb,
+	0x98, 0x08, 0x87, 0xb1, 0x40, 0xaa, 0xae, 0x4e, 0xa8, 0x7e, 0x20, 0x85, 0xe8, 0x87, 0x70, 0x3b,
+	0x22, 0x67, 0xdc, 0x49, 0xf5, 0x25, 0xc7, 0xf7, 0xac, 0x25, 0xa9, 0xfd, 0x86, 0x00, 0xa5, 0xf8,
+	0x4f, 0xcb, 0xfb, 0x30, 0xb7, 0x90, 0x2d, 0xcf, 0xd6, 0x7e, 0x3b, 0x0f, 0x79, 0x15, 0x00, 0x68,
+	0x1f, 0x8a, 0x5d, 0x42, 0x9c, 0x3e, 0x61, 0x2e, 0x89, 0x38, 0xee, 0x11, 0x19, 0x32, 0x8b, 0x5b,
+	0x77, 0xc5, 0xde, 0xff, 0xf5, 0x7c, 0xfd, 0x4d, 0x15, 0xbc, 0xb1, 0xf7, 0xa4, 0xee, 0xd3, 0x46,
+	0x88, 0xf9, 0x71, 0xbd, 0x4d, 0x7a, 0xd8, 0x3d, 0xdf, 0x21, 0xee, 0x1f, 0xfe, 0xfb, 0xe7, 0x7b,
+	0x19, 0xbb, 0xd0, 0x25, 0xe4, 0x20, 0x51, 0x47, 0x1f, 0x41, 0x11, 0x07, 0x01, 0x3d, 0x25, 0x9e,
+	0xc3, 0xe8, 0x40, 0xb8, 0x61, 0x56, 0xda, 0x7d, 0x2d, 0xf5, 0x64, 0xa5, 0x4d, 0x05, 0x4c, 0x1b,
+	0xbb, 0xa0, 0x75, 0xe5, 0x5c, 0x8c, 0x5a, 0x50, 0xf0, 0x48, 0xe4, 0x0f, 0xb9, 0x66, 0xaf, 0xc0,
+	0xb5, 0xac, 0x54, 0x35, 0xd5, 0x07, 0x20, 0x16, 0xea, 0x08, 0x9b, 0x30, 0xdf, 0x23, 0x26, 0xf2,
+	0x6e, 0xa7, 0x50, 0x11, 0xb2, 0xaf, 0x51, 0x86, 0xa9, 0x3b, 0x9c, 0x8a, 0xd1, 0xd7, 0x14, 0x13,
+	0x23, 0xae, 0xdf, 0xf7, 0x49, 0xc4, 0xad, 0x39, 0x61, 0x31, 0x09, 0xb2, 0xcd, 0x1c, 0xda, 0x82,
+	0xa5, 0x61, 0xf8, 0x98, 0xc8, 0x79, 0xf3, 0x15, 0x91, 0xa3, 0x3f, 0x05, 0x49, 0xc0, 0xc8, 0xdd,
+	0x33, 0xc2, 0xd9, 0xb9, 0x73, 0x84, 0xdd, 0x27, 0xb4, 0xdb, 0xb5, 0xe6, 0x37, 0x32, 0xe9, 0xbb,
+	0xb7, 0x05, 0x6c, 0x4b, 0xa1, 0xcc, 0x9a, 0xd9, 0xc8, 0x1c, 0xba, 0x07, 0x95, 0x10, 0x9f, 0x25,
+	0x21, 0xe1, 0x91, 0x3e, 0x3f, 0xb6, 0x16, 0x36, 0x32, 0x77, 0x0b, 0x76, 0x29, 0xc4, 0x67, 0xda,
+	0x7a, 0x3b, 0x62, 0x1a, 0xd5, 0xa0, 0x20, 0xb0, 0x21, 0x09, 0xa9, 0x13, 0xfb, 0x9f, 0x10, 0xe9,
+	0xce, 0x05, 0x7b, 0x29, 0xc4, 0x67, 0x1f, 0x93, 0x90, 0x76, 0xfc, 0x4f, 0x08, 0x6a, 0x42, 0x31,
+	0xa0, 0xb4, 0xef, 0x78, 0x84, 0x13, 0x57, 0xe4, 0x6a, 0xe9, 0xb8, 0xc5, 0xb4, 0x64, 0xd3, 0xa6,
+	0xb4, 0xbf, 0x63, 0x60, 0x76, 0x21, 0x18, 0x1d, 0xa2, 0xfb, 0x80, 0xa4, 0xf3, 0xe2, 0xa3, 0x80,
+	0x18, 0xb7, 0x3e, 0x97, 0x6e, 0xbc, 0x60, 0x57, 0x12, 0x89, 0xf6, 0xe5, 0x73, 0xf4, 0x18, 0x10,
+	0x23, 0x01, 0x3e, 0x27, 0xcc, 0xf1, 0x23, 0xe1, 0x71, 0xfe, 0x09, 0x89, 0xad, 0x65, 0x69, 0xdc,
+	0x5a, 0x9a, 0x59, 0x24, 0xb6, 0x65, 0xa0, 0xda, 0x34, 0x15, 0x36, 0x31, 0x1f, 0xa3, 0x87, 0xb0,
+	0xa0, 0x43, 0x2e, 0xb6, 0x0a, 0xd2, 0xca, 0xd5, 0x69, 0xba, 0x43, 0x8d, 0xb0, 0x13, 0x2c, 0x6a,
+	0x43, 0x79, 0x32, 0x92, 0xad, 0xa2, 0xd4, 0xff, 0xea, 0x85, 0xfa, 0x26, 0xa8, 0xed, 0xd2, 0x44,
+	0x94, 0xd7, 0xfe, 0x91, 0x81, 0x05, 0xf3, 0x11, 0xf4, 0x4d, 0xb1, 0x57, 0xce, 0x7c, 0x12, 0x3b,
+	0x34, 0x72, 0x34, 0x54, 0x46, 0x67, 0xc1, 0x2e, 0x6b, 0xc9, 0x7e, 0xa4, 0xe1, 0xa8, 0x0d, 0x25,
+	0x73, 0xb8, 0x06, 0x9a, 0x95, 0xeb, 0x78, 0xa3, 0xae, 0xae, 0xcf, 0xba, 0xb9, 0x3e, 0xeb, 0x3b,
+	0xfa, 0x7a, 0xdd, 0x5a, 0x10, 0xd6, 0xf8, 0xdd, 0xbf, 0xd7, 0x33, 0x76, 0x51, 0xeb, 0x1a, 0xb6,
+	0x0f, 0xa1, 0xa8, 0x12, 0x74, 0x42, 0x36, 0x7b, 0x79, 0xb2, 0x82, 0x52, 0xd5, 0x5c, 0xb5, 0x18,
+	0x4a, 0x13, 0x1b, 0x47, 0xdf, 0x87, 0xfc, 0xa9, 0x1f, 0x79, 0xf4, 0xd4, 0xca, 0x5c, 0x9e, 0x56,
+	0xab, 0x88, 0xf0, 0x53, 0xb9, 0xd2, 0xd1, 0x1c, 0x59, 0x99, 0xf4, 0x96, 0xd5, 0xe4, 0x63, 0x39,
+	0x57, 0xfb, 0x4d, 0x06, 0x96, 0x47, 0x83, 0x02, 0xfd, 0x00, 0x16, 0x62, 0x2e, 0x62, 0xab, 0x77,
+	0x2e, 0x3f, 0x5a, 0x4c, 0x3b, 0x20, 0x0d, 0xee, 0x68, 0xa0, 0x9d, 0xa8, 0xa0, 0x1d, 0x10, 0xee,
+	0x7f, 0x1d, 0xd3, 0x42, 0x88, 0xcf, 0x8c, 0x29, 0x02, 0x58, 0x1e, 0xcd, 0x53, 0xe2, 0x88, 0xfd,
+	0xe8, 0x88, 0x0a, 0x3b, 0xbb, 0xc7, 0x38, 0x8a, 0x48, 0x20, 0x92, 0xb8, 0x4c, 0xc0, 0x76, 0x59,
+	0x4b, 0xb6, 0x95, 0xa0, 0xe5, 0xa1, 0x3a, 0xac, 0xd0, 0x01, 0x9f, 0x82, 0x67, 0x25, 0xbc, 0x62,
+	0x44, 0x09, 0xbe, 0xf6, 0x8b, 0x2c, 0x2c, 0x8d, 0xe4, 0x32, 0x74, 0x1b, 0x60, 0xea, 0x2b, 0x8b,
+	0x6e, 0x42, 0x7f, 0x03, 0xe6, 0x3c, 0x12, 0xd1, 0x50, 0x13, 0xaa, 0x41, 0xca, 0xfd, 0x30, 0xfb,
+	0xff, 0xdd, 0x0f, 0x0f, 0x61, 0x3e, 0x14, 0x45, 0x16, 0x21, 0x56, 0x4e, 0x32, 0xdd, 0xd6, 0x4c,
+	0xab, 0xd3, 0x4c, 0xad, 0x88, 0xdb, 0xf9, 0xd0, 0x8f, 0x9a, 0x44, 0xe9, 0x89, 0x0c, 0x46, 0x88,
+	0x35, 0x77, 0x39, 0x3d, 0x7c, 0xd6, 0x24, 0xa4, 0xf6, 0xf7, 0x2c, 0x94, 0x27, 0xf3, 0xc0, 0xeb,
+	0x4c, 0xb1, 0x27, 0xdc, 0xdf, 0x3d, 0x11, 0x1f, 0x73, 0xe2, 0x63, 0xcc, 0x88, 0x95, 0xbd, 0xe2,
+	0xa6, 0x97, 0x85, 0x7e, 0x93, 0x90, 0x8e, 0xd0, 0x46, 0x6d, 0x28, 0x60, 0xf7, 0xc9, 0x08, 0xdd,
+	0x55, 0x6d, 0xb8, 0x84, 0xdd, 0x27, 0x09, 0xdb, 0x21, 0x54, 0x4c, 0xce, 0x19, 0x32, 0xe6, 0xae,
+	0xc8, 0x68, 0x72, 0x4f, 0xc2, 0xfa, 0x56, 0x12, 0xf2, 0xd8, 0xf3, 0x18, 0x89, 0x63, 0x7d, 0xad,
+	0xe9, 0x68, 0xde, 0x54, 0x93, 0xb5, 0xbf, 0x64, 0x60, 0x31, 0xb9, 0xb3, 0xae, 0xe7, 0x52, 0xef,
+	0x83, 0x88, 0x09, 0x07, 0x87, 0x74, 0x10, 0x71, 0x6b, 0xf6, 0x32, 0x87, 0xb9, 0x18, 0xe2, 0xb3,
+	0x4d, 0x89, 0x17, 0xb9, 0xa3, 0x4f, 0x98, 0x4f, 0x3d, 0x2b, 0x77, 0xf9, 0x20, 0xd4, 0x2a, 0xb5,
+	0x5f, 0x67, 0xa0, 0x30, 0x56, 0xab, 0xa2, 0x47, 0xb0, 0xac, 0xd2, 0x88, 0x13, 0x73, 0xcc, 0xb8,
+	0x4e, 0x48, 0xd5, 0x29, 0xd2, 0xa4, 0x78, 0x53, 0xac, 0x9f, 0x0a, 0xd6, 0x25, 0xa5, 0xd9, 0x11,
+	0x8a, 0xe8, 0x3b, 0x90, 0xd7, 0x3b, 0xca, 0x5e, 0xca, 0x3d, 0x15, 0xb8, 0xf6, 0xb7, 0x0c, 0x94,
+	0x92, 0x15, 0x6d, 0x1f, 0x63, 0xd6, 0xbb, 0x66, 0xa0, 0x0e, 0xbf, 0x3f, 0x7b, 0x85, 0xef, 0x4f,
+	0xed, 0x3f, 0x77, 0xcd, 0xfd, 0xd7, 0xfe, 0x9a, 0x87, 0xe2, 0xf8, 0x8b, 0x48, 0x94, 0xb9, 0x94,
+	0xf9, 0x3d, 0x3f, 0xc2, 0x81, 0x13, 0x93, 0xc8, 0x23, 0x2c, 0xf1, 0x2d, 0xb5, 0xa9, 0x55, 0x23,
+	0xee, 0x48, 0xa9, 0xf6, 0x31, 0x51, 0xac, 0x68, 0x57, 0x9c, 0x4a, 0x73, 0x25, 0x25, 0x18, 0x26,
+	0xc5, 0x3b, 0x89, 0xdb, 0xf6, 0x29, 0xe3, 0x02, 0x38, 0xab, 0xaa, 0x31, 0x35, 0x7b, 0x40, 0x19,
+	0x6f, 0x79, 0xe8, 0x01, 0xac, 0xaa, 0x64, 0xef, 0xc4, 0xcc, 0x1d, 0x65, 0x95, 0x61, 0x63, 0x23,
+	0x25, 0xec, 0x30, 0x77, 0x48, 0xfc, 0x0e, 0xa0, 0x11, 0x15, 0x43, 0xae, 0x62, 0xa2, 0x94, 0xe0,
+	0x35, 0xff, 0x7b, 0x60, 0x69, 0xb0, 0x89, 0xcc, 0xe1, 0x63, 0x20, 0x2f, 0xaf, 0xa7, 0x9b, 0x4a,
+	0xae, 0x6f, 0x82, 0xe1, 0x53, 0xe0, 0xdd, 0x64, 0x65, 0x46, 0x53, 0x3f, 0x04, 0xe6, 0xe5, 0x97,
+	0x56, 0xc6, 0xd4, 0xf4, 0x33, 0x60, 0x1d, 0x96, 0xb4, 0x8e, 0x87, 0x39, 0x96, 0x65, 0xdc, 0xb2,
+	0x0d, 0x6a, 0x6a, 0x07, 0x73, 0x8c, 0xbe, 0x01, 0xda, 0x4e, 0x4e, 0x4c, 0x7e, 0x36, 0x20, 0x91,
+	0x4b, 0xf4, 0x93, 0x44, 0xdb, 0xaa, 0xa3, 0x67, 0xd1, 0x3b, 0x50, 0xd1, 0x95, 0x84, 0xc3, 0x48,
+	0x88, 0xfd, 0xc8, 0x8f, 0x7a, 0xb2, 0x92, 0x9b, 0x4b, 0x4a, 0x0c, 0xdb, 0xcc, 0x23, 0x0b, 0xe6,
+	0xcd, 0xfd, 0xa7, 0xde, 0x19, 0x66, 0x88, 0xee, 0x40, 0x21, 0xa2, 0x91, 0xe2, 0x16, 0xf5, 0x9a,
+	0xb5, 0x2c, 0x0b, 0xb8, 0xf1, 0xc9, 0xe9, 0x72, 0xb6, 0x70, 0xed, 0x72, 0x76, 0x64, 0xdd, 0x98,
+	0x73, 0x12, 0xf6, 0x39, 0xf1, 0xac, 0xe2, 0x58, 0x69, 0xb4, 0x69, 0xe6, 0x87, 0xf1, 0x52, 0x1a,
+	0x8d, 0x97, 0x9b, 0x49, 0xbc, 0x94, 0xe5, 0xb4, 0x1e, 0x29, 0xdb, 0xc9, 0x72, 0x33, 0x71, 0xd6,
+	0x8a, 0x04, 0x14, 0xf5, 0xb4, 0xf1, 0xd2, 0x8f, 0xa1, 0x32, 0xf2, 0x40, 0x76, 0x65, 0xe8, 0x5a,
+	0xe8, 0xa2, 0xda, 0x6f, 0x22, 0xc6, 0xed, 0x12, 0x1b, 0x9f, 0xa8, 0xfd, 0x29, 0x0b, 0x68, 0xfa,
+	0x21, 0xfa, 0xba, 0x5c, 0x70, 0x0b, 0xe6, 0x8d, 0x6b, 0xaa, 0x00, 0xc9, 0xf7, 0x95, 0x47, 0x56,
+	0x61, 0x21, 0x39, 0xfb, 0x59, 0x79, 0x5a, 0xc9, 0x78, 0x68, 0x90, 0x5c, 0xba, 0x41, 0xe6, 0xc6,
+	0x0c, 0x62, 0xc1, 0x7c, 0x3c, 0x70, 0x5d, 0x61, 0x88, 0xbc, 0x3c, 0x56, 0x33, 0x14, 0x3c, 0x84,
+	0x31, 0xca, 0xb4, 0xaf, 0xaa, 0x81, 0xc0, 0x1f, 0xe3, 0xc8, 0x0b, 0x08, 0x93, 0x9e, 0xb9, 0x68,
+	0x9b, 0x61, 0xba, 0xc5, 0x16, 0xaf, 0x6d, 0x31, 0x17, 0x0a, 0x63, 0x8d, 0x8c, 0x6b, 0xe7, 0x9b,
+	0x2a, 0x2c, 0x98, 0xa6, 0x89, 0xb6, 0x62, 0x32, 0x16, 0xf7, 0xdd, 0x4a, 0x4a, 0xa3, 0xe7, 0x4b,
+	0x39, 0x97, 0x03, 0x28, 0x4f, 0xb6, 0x9f, 0x74, 0x3e, 0xde, 0x78, 0x5d, 0xf7, 0xc9, 0xb4, 0x5b,
+	0xc6, 0x1b, 0x4f, 0xb5, 0x5f, 0x66, 0x00, 0x4d, 0xf7, 0x66, 0xae, 0x77, 0xc1, 0x7c, 0x0f, 0x72,
+	0xa2, 0x0b, 0xa4, 0x5f, 0x02, 0xeb, 0xaf, 0x69, 0x02, 0xe9, 0x05, 0x49, 0x95, 0x9a, 0x0f, 0x2b,
+	0x29, 0x5d, 0x9c, 0x2f, 0xc3, 0x86, 0xb5, 0xe7, 0x19, 0xb8, 0x75, 0x41, 0x3f, 0xe7, 0x8a, 0xe5,
+	0xf6, 0xd7, 0xa1, 0x64, 0xd0, 0xe3, 0xcb, 0x28, 0xe8, 0x69, 0x9d, 0xfb, 0xdf, 0x06, 0xa3, 0xeb,
+	0x4c, 0xac, 0xca, 0xe8, 0x27, 0xe9, 0x76, 0x0b, 0xf2, 0xaa, 0x19, 0xa5, 0x8f, 0xf5, 0xce, 0x65,
+	0x7a, 0x51, 0xa6, 0x47, 0xa9, 0x34, 0x6b, 0x9f, 0x65, 0x61, 0x25, 0xa5, 0xb7, 0x83, 0x8a, 0x90,
+	0xd5, 0x9b, 0xc9, 0xd9, 0x59, 0xdf, 0x7b, 0x55, 0x30, 0x64, 0x5f, 0x15, 0x0c, 0xdf, 0x1d, 0xab,
+	0x23, 0x44, 0x7d, 0xa5, 0x0a, 0x88, 0xba, 0x68, 0xdf, 0xd6, 0x75, 0xfb, 0xb6, 0xbe, 0x4d, 0xfd,
+	0xc8, 0x2c, 0x4c, 0xe7, 0x89, 0x14, 0x7b, 0xe5, 0xd2, 0xec, 0x95, 0x7e, 0x0a, 0x73, 0x17, 0x9c,
+	0x42, 0x9a, 0x75, 0xf3, 0xe9, 0xd6, 0x4d, 0x4d, 0x47, 0xf7, 0x7e, 0x0e, 0x85, 0xb1, 0x0e, 0x04,
+	0xfa, 0x16, 0xdc, 0x68, 0xef, 0xef, 0x1f, 0x38, 0x3b, 0xbb, 0x87, 0xbb, 0xdb, 0x87, 0xad, 0xfd,
+	0x3d, 0x67, 0xb3, 0xdd, 0xde, 0x7f, 0x5c, 0x9e, 0xa9, 0xde, 0x7c, 0xfa, 0x6c, 0x03, 0x8d, 0x81,
+	0x37, 0x45, 0x37, 0x4a, 0xdc, 0xd1, 0x13, 0x1a, 0x9d, 0x43, 0xbb, 0xb5, 0x7d, 0x58, 0xce, 0x54,
+	0x6f, 0x3d, 0x7d, 0xb6, 0xb1, 0x32, 0xa6, 0xd2, 0xe1, 0xcc, 0x77, 0x79, 0x35, 0xf7, 0xab, 0xdf,
+	0xaf, 0xcd, 0xdc, 0xfb, 0x2c, 0x03, 0xa5, 0x89, 0x47, 0x25, 0xba, 0x07, 0xab, 0x5b, 0x9b, 0xdb,
+	0x1f, 0xed, 0x37, 0x9b, 0x82, 0x66, 0xf3, 0x70, 0xf7, 0xd1, 0x4f, 0x9c, 0xbd, 0xfd, 0xbd, 0xdd,
+	0xf2, 0x4c, 0xb5, 0xf4, 0xf4, 0xd9, 0xc6, 0x92, 0xc6, 0xef, 0xd1, 0x88, 0xa0, 0x3a, 0xdc, 0x9a,
+	0xc2, 0xb6, 0x5b, 0x7b, 0xbb, 0x9b, 0x76, 0x39, 0x53, 0xad, 0x3c, 0x7d, 0xb6, 0x51, 0xd0, 0xe8,
+	0xb6, 0x1f, 0x11, 0xcc, 0xd0, 0x7b, 0xf0, 0x95, 0x29, 0xfc, 0xee, 0x8f, 0x0f, 0xf6, 0xf7, 0x76,
+	0xf7, 0x0e, 0x5b, 0x9b, 0xed, 0x72, 0x56, 0xed, 0x51, 0x2b, 0xed, 0x9e, 0xf5, 0x69, 0x24, 0x5e,
+	0x44, 0x38, 0x50, 0xeb, 0xdd, 0xea, 0x7f, 0xfe, 0x62, 0x2d, 0xf3, 0xc5, 0x8b, 0xb5, 0xcc, 0x7f,
+	0x5e, 0xac, 0x65, 0x3e, 0x7d, 0xb9, 0x36, 0xf3, 0xc5, 0xcb, 0xb5, 0x99, 0x7f, 0xbe, 0x5c, 0x9b,
+	0xf9, 0xe9, 0x8f, 0x7a, 0x3e, 0x3f, 0x1e, 0x1c, 0xd5, 0x5d, 0x1a, 0x36, 0x74, 0x3f, 0xdf, 0x3f,
+	0x72, 0xef, 0xe3, 0x7e, 0x3f, 0x6e, 0x84, 0xbe, 0xe7, 0x05, 0xe4, 0x14, 0x33, 0xd2, 0x50, 0xfe,
+	0x7c, 0x5f, 0x3b, 0xf4, 0xfd, 0x11, 0xc9, 0xc9, 0x7b, 0x8d, 0xf1, 0x9f, 0x18, 0xf8, 0x79, 0x9f,
+	0xc4, 0x47, 0x79, 0x59, 0x61, 0x7e, 0xfb, 0x7f, 0x03, 0x00, 0x12, 0x74, 0x54, 0x43, 0x80, 0x18,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRecoveredFundsClaimId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRecoveredFundsClaimId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeoutWatchdogHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutWatchdogHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeoutWatchdogTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutWatchdogTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SplitForwardResults) > 0 {
+		for iNdEx := len(m.SplitForwardResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitForwardResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ForceRefundedPackets) > 0 {
+		for iNdEx := len(m.ForceRefundedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForceRefundedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for iNdEx := len(m.RateLimitFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClaimDelegates) > 0 {
+		for iNdEx := len(m.ClaimDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimDelegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecoveredFundsClaims) > 0 {
+		for iNdEx := len(m.RecoveredFundsClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveredFundsClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutWatchdog != nil {
+		{
+			size, err := m.TimeoutWatchdog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Timeouts != nil {
+		{
+			size, err := m.Timeouts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RelayerIncentives) > 0 {
+		for iNdEx := len(m.RelayerIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ClaimableRecovery {
+		i--
+		if m.ClaimableRecovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LoopDetection != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LoopDetection))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxMemoSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMemoSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxForwardDepth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxForwardDepth))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.RetryBackoff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeOverrides) > 0 {
+		for iNdEx := len(m.FeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeniedRoutes) > 0 {
+		for iNdEx := len(m.DeniedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeniedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedRoutes) > 0 {
+		for iNdEx := len(m.AllowedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Timeouts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timeouts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Timeouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RefundTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.RetriesOnTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesOnTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeoutWatchdog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeoutWatchdog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutWatchdog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeightWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HeightWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryBackoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryBackoff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryBackoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.Strategy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutboundChannelId) > 0 {
+		i -= len(m.OutboundChannelId)
+		copy(dAtA[i:], m.OutboundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OutboundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TimeoutFeeShare.Size()
+		i -= size
+		if _, err := m.TimeoutFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AckFeeShare.Size()
+		i -= size
+		if _, err := m.AckFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RecvFeeShare.Size()
+		i -= size
+		if _, err := m.RecvFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGenesis(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitCharge != nil {
+		{
+			size, err := m.RateLimitCharge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecoverAddress) > 0 {
+		i -= len(m.RecoverAddress)
+		copy(dAtA[i:], m.RecoverAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecoverAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RetriesAttempted != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesAttempted))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.RetryBackoff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.Nonrefundable {
+		i--
+		if m.Nonrefundable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PacketTimeoutHeight) > 0 {
+		i -= len(m.PacketTimeoutHeight)
+		copy(dAtA[i:], m.PacketTimeoutHeight)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PacketSrcPortId) > 0 {
+		i -= len(m.PacketSrcPortId)
+		copy(dAtA[i:], m.PacketSrcPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PacketSrcChannelId) > 0 {
+		i -= len(m.PacketSrcChannelId)
+		copy(dAtA[i:], m.PacketSrcChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitForwardResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitForwardResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitForwardResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitCharge != nil {
+		{
+			size, err := m.RateLimitCharge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Handler) > 0 {
+		i -= len(m.Handler)
+		copy(dAtA[i:], m.Handler)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Handler)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacketEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacketEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacketEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlowEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlowEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlowEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceRefundedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceRefundedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceRefundedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitForwardResultEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitForwardResultEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitForwardResultEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InboundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveredFundsClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveredFundsClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveredFundsClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InboundChannelId) > 0 {
+		i -= len(m.InboundChannelId)
+		copy(dAtA[i:], m.InboundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InboundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InboundPortId) > 0 {
+		i -= len(m.InboundPortId)
+		copy(dAtA[i:], m.InboundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InboundPortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecoveredFundsClaims) > 0 {
+		for _, e := range m.RecoveredFundsClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimDelegates) > 0 {
+		for _, e := range m.ClaimDelegates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for _, e := range m.RateLimitFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForceRefundedPackets) > 0 {
+		for _, e := range m.ForceRefundedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SplitForwardResults) > 0 {
+		for _, e := range m.SplitForwardResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimeoutWatchdogTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutWatchdogTimestamp))
+	}
+	if m.TimeoutWatchdogHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutWatchdogHeight))
+	}
+	if m.NextRecoveredFundsClaimId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRecoveredFundsClaimId))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AllowedRoutes) > 0 {
+		for _, e := range m.AllowedRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedRoutes) > 0 {
+		for _, e := range m.DeniedRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeOverrides) > 0 {
+		for _, e := range m.FeeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RetryBackoff.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxForwardDepth != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxForwardDepth))
+	}
+	if m.MaxMemoSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMemoSize))
+	}
+	if m.LoopDetection != 0 {
+		n += 1 + sovGenesis(uint64(m.LoopDetection))
+	}
+	if m.ClaimableRecovery {
+		n += 2
+	}
+	if len(m.RelayerIncentives) > 0 {
+		for _, e := range m.RelayerIncentives {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Timeouts != nil {
+		l = m.Timeouts.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutWatchdog != nil {
+		l = m.TimeoutWatchdog.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Timeouts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetriesOnTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesOnTimeout))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TimeoutWatchdog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.HeightWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.HeightWindow))
+	}
+	return n
+}
+
+func (m *RetryBackoff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovGenesis(uint64(m.Strategy))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ForwardRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.OutboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RelayerIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RecvFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AckFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TimeoutFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
+	}
+	l = len(m.PacketTimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	if m.Nonrefundable {
+		n += 2
+	}
+	l = m.RetryBackoff.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RetriesAttempted != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesAttempted))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RecoverAddress)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.RateLimitCharge != nil {
+		l = m.RateLimitCharge.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SplitForwardResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RateLimitCharge != nil {
+		l = m.RateLimitCharge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *InFlightPacketEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.InFlightPacket.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlowEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Flow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ForceRefundedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *SplitForwardResultEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.InboundSequence))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RecoveredFundsClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.InboundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.InboundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.InboundSequence))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacketEntry{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFundsClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveredFundsClaims = append(m.RecoveredFundsClaims, RecoveredFundsClaim{})
+			if err := m.RecoveredFundsClaims[len(m.RecoveredFundsClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDelegates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimDelegates = append(m.ClaimDelegates, ClaimDelegate{})
+			if err := m.ClaimDelegates[len(m.ClaimDelegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitFlows = append(m.RateLimitFlows, RateLimitFlowEntry{})
+			if err := m.RateLimitFlows[len(m.RateLimitFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRefundedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceRefundedPackets = append(m.ForceRefundedPackets, ForceRefundedPacket{})
+			if err := m.ForceRefundedPackets[len(m.ForceRefundedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitForwardResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitForwardResults = append(m.SplitForwardResults, SplitForwardResultEntry{})
+			if err := m.SplitForwardResults[len(m.SplitForwardResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutWatchdogTimestamp", wireType)
+			}
+			m.TimeoutWatchdogTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutWatchdogTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutWatchdogHeight", wireType)
+			}
+			m.TimeoutWatchdogHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutWatchdogHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecoveredFundsClaimId", wireType)
+			}
+			m.NextRecoveredFundsClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecoveredFundsClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRoutes = append(m.AllowedRoutes, ForwardRoute{})
+			if err := m.AllowedRoutes[len(m.AllowedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedRoutes = append(m.DeniedRoutes, ForwardRoute{})
+			if err := m.DeniedRoutes[len(m.DeniedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeOverrides = append(m.FeeOverrides, FeeOverride{})
+			if err := m.FeeOverrides[len(m.FeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardDepth", wireType)
+			}
+			m.MaxForwardDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoSize", wireType)
+			}
+			m.MaxMemoSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoopDetection", wireType)
+			}
+			m.LoopDetection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoopDetection |= LoopDetection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableRecovery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimableRecovery = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerIncentives = append(m.RelayerIncentives, RelayerIncentive{})
+			if err := m.RelayerIncentives[len(m.RelayerIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeouts == nil {
+				m.Timeouts = &Timeouts{}
+			}
+			if err := m.Timeouts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutWatchdog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutWatchdog == nil {
+				m.TimeoutWatchdog = &TimeoutWatchdog{}
+			}
+			if err := m.TimeoutWatchdog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timeouts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timeouts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timeouts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesOnTimeout", wireType)
+			}
+			m.RetriesOnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesOnTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RefundTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeoutWatchdog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutWatchdog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutWatchdog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightWindow", wireType)
+			}
+			m.HeightWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryBackoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryBackoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryBackoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= BackoffStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecvFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AckFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+			}
+			m.PacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nonrefundable = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesAttempted", wireType)
+			}
+			m.RetriesAttempted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesAttempted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitCharge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimitCharge == nil {
+				m.RateLimitCharge = &RateLimitCharge{}
+			}
+			if err := m.RateLimitCharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitForwardResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitForwardResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitForwardResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitCharge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimitCharge == nil {
+				m.RateLimitCharge = &RateLimitCharge{}
+			}
+			if err := m.RateLimitCharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.FeePercentage.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *InFlightPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OriginalSenderAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.RefundChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.RefundPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PacketSrcChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PacketSrcPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.PacketTimeoutTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
-	}
-	l = len(m.PacketTimeoutHeight)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PacketData)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.RefundSequence != 0 {
-		n += 1 + sovGenesis(uint64(m.RefundSequence))
-	}
-	if m.RetriesRemaining != 0 {
-		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovGenesis(uint64(m.Timeout))
-	}
-	if m.Nonrefundable {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *InFlightPacketEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -624,15 +6886,98 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: InFlightPacketEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InFlightPacketEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -659,13 +7004,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlowEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlowEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlowEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -692,105 +7151,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InFlightPackets == nil {
-				m.InFlightPackets = make(map[string]InFlightPacket)
-			}
-			var mapkey string
-			mapvalue := &InFlightPacket{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &InFlightPacket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.InFlightPackets[mapkey] = *mapvalue
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -813,7 +7176,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ForceRefundedPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +7199,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceRefundedPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceRefundedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,10 +7235,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -897,7 +7309,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+func (m *SplitForwardResultEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -920,15 +7332,15 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitForwardResultEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitForwardResultEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -956,11 +7368,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -988,13 +7400,13 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
 			}
-			var stringLen uint64
+			m.InboundSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1004,29 +7416,16 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InboundSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RefundPortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1036,61 +7435,80 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveredFundsClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveredFundsClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveredFundsClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PacketTimeoutTimestamp = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1100,14 +7518,14 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1135,13 +7553,13 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1151,31 +7569,30 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
-			if m.PacketData == nil {
-				m.PacketData = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPortId", wireType)
 			}
-			m.RefundSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1185,16 +7602,29 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RefundSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.RetriesRemaining = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannelId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1204,16 +7634,29 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetriesRemaining |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
 			}
-			m.Timeout = 0
+			m.InboundSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1223,16 +7666,16 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				m.InboundSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1242,12 +7685,24 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Nonrefundable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
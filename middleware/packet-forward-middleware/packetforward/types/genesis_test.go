@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	inFlightPacket := types.InFlightPacket{RefundChannelId: "channel-1", RefundPortId: "transfer", RefundSequence: 1}
+	entry := types.InFlightPacketEntry{ChannelId: "channel-0", PortId: "transfer", Sequence: 1, InFlightPacket: inFlightPacket}
+	claim := types.RecoveredFundsClaim{Id: 1, OriginalSenderAddress: "cosmos1alice", Amount: sdk.NewInt64Coin("uatom", 100)}
+	delegate := types.ClaimDelegate{OriginalSenderAddress: "cosmos1alice", Delegate: sdk.AccAddress("delegate").String()}
+	rateLimit := types.RateLimit{ChannelId: "channel-0", Denom: "uatom", MaxAmount: sdkmath.NewInt(1000), Period: time.Hour}
+	flow := types.RateLimitFlowEntry{ChannelId: "channel-0", Denom: "uatom", Flow: types.RateLimitFlow{Amount: sdkmath.NewInt(100)}}
+	forceRefunded := types.ForceRefundedPacket{ChannelId: "channel-0", PortId: "transfer", Sequence: 1}
+	splitResult := types.SplitForwardResultEntry{InboundChannelId: "channel-1", InboundPortId: "transfer", InboundSequence: 1, Result: types.SplitForwardResult{
+		ChannelId: "channel-0", PortId: "transfer", Sequence: 1, Denom: "transfer/channel-1/uatom", Amount: "100", Success: true,
+	}}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+		expErr   bool
+	}{
+		{"default", func(*types.GenesisState) {}, false},
+		{"valid", func(gs *types.GenesisState) {
+			gs.InFlightPackets = []types.InFlightPacketEntry{entry}
+			gs.RecoveredFundsClaims = []types.RecoveredFundsClaim{claim}
+			gs.NextRecoveredFundsClaimId = 2
+			gs.ClaimDelegates = []types.ClaimDelegate{delegate}
+			gs.Params.RateLimits = []types.RateLimit{rateLimit}
+			gs.RateLimitFlows = []types.RateLimitFlowEntry{flow}
+			gs.ForceRefundedPackets = []types.ForceRefundedPacket{forceRefunded}
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{splitResult}
+			gs.TimeoutWatchdogTimestamp = 1_700_000_000_000_000_000
+			gs.TimeoutWatchdogHeight = 100
+		}, false},
+		{"invalid channel", func(gs *types.GenesisState) {
+			e := entry
+			e.ChannelId = "channel/0"
+			gs.InFlightPackets = []types.InFlightPacketEntry{e}
+		}, true},
+		{"invalid port", func(gs *types.GenesisState) {
+			e := entry
+			e.PortId = ""
+			gs.InFlightPackets = []types.InFlightPacketEntry{e}
+		}, true},
+		{"zero sequence", func(gs *types.GenesisState) {
+			e := entry
+			e.Sequence = 0
+			gs.InFlightPackets = []types.InFlightPacketEntry{e}
+		}, true},
+		{"invalid refund channel", func(gs *types.GenesisState) {
+			e := entry
+			e.InFlightPacket.RefundChannelId = ""
+			gs.InFlightPackets = []types.InFlightPacketEntry{e}
+		}, true},
+		{"duplicate in-flight packet", func(gs *types.GenesisState) {
+			gs.InFlightPackets = []types.InFlightPacketEntry{entry, entry}
+		}, true},
+		{"zero claim id", func(gs *types.GenesisState) {
+			c := claim
+			c.Id = 0
+			gs.RecoveredFundsClaims = []types.RecoveredFundsClaim{c}
+		}, true},
+		{"zero claim amount", func(gs *types.GenesisState) {
+			c := claim
+			c.Amount = sdk.NewInt64Coin("uatom", 0)
+			gs.RecoveredFundsClaims = []types.RecoveredFundsClaim{c}
+		}, true},
+		{"duplicate claim", func(gs *types.GenesisState) {
+			gs.RecoveredFundsClaims = []types.RecoveredFundsClaim{claim, claim}
+		}, true},
+		{"claim id not below next claim id", func(gs *types.GenesisState) {
+			gs.RecoveredFundsClaims = []types.RecoveredFundsClaim{claim}
+			gs.NextRecoveredFundsClaimId = 1
+		}, true},
+		{"invalid delegate", func(gs *types.GenesisState) {
+			d := delegate
+			d.Delegate = "cosmos1invalid"
+			gs.ClaimDelegates = []types.ClaimDelegate{d}
+		}, true},
+		{"duplicate delegate", func(gs *types.GenesisState) {
+			gs.ClaimDelegates = []types.ClaimDelegate{delegate, delegate}
+		}, true},
+		{"rate limit flow without rate limit", func(gs *types.GenesisState) {
+			gs.RateLimitFlows = []types.RateLimitFlowEntry{flow}
+		}, true},
+		{"negative rate limit flow", func(gs *types.GenesisState) {
+			f := flow
+			f.Flow.Amount = sdkmath.NewInt(-1)
+			gs.Params.RateLimits = []types.RateLimit{rateLimit}
+			gs.RateLimitFlows = []types.RateLimitFlowEntry{f}
+		}, true},
+		{"duplicate rate limit flow", func(gs *types.GenesisState) {
+			gs.Params.RateLimits = []types.RateLimit{rateLimit}
+			gs.RateLimitFlows = []types.RateLimitFlowEntry{flow, flow}
+		}, true},
+		{"zero force refunded sequence", func(gs *types.GenesisState) {
+			p := forceRefunded
+			p.Sequence = 0
+			gs.ForceRefundedPackets = []types.ForceRefundedPacket{p}
+		}, true},
+		{"duplicate force refunded packet", func(gs *types.GenesisState) {
+			gs.ForceRefundedPackets = []types.ForceRefundedPacket{forceRefunded, forceRefunded}
+		}, true},
+		{"invalid split forward inbound channel", func(gs *types.GenesisState) {
+			r := splitResult
+			r.InboundChannelId = ""
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{r}
+		}, true},
+		{"invalid split forward amount", func(gs *types.GenesisState) {
+			r := splitResult
+			r.Result.Amount = "0"
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{r}
+		}, true},
+		{"duplicate split forward result", func(gs *types.GenesisState) {
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{splitResult, splitResult}
+		}, true},
+		{"handled split forward result", func(gs *types.GenesisState) {
+			r := splitResult
+			r.Result = types.SplitForwardResult{Handler: "swap", Sequence: 0, Denom: "transfer/channel-1/uatom", Amount: "100", Success: true}
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{splitResult, r}
+		}, false},
+		{"handled split forward result with channel", func(gs *types.GenesisState) {
+			r := splitResult
+			r.Result.Handler = "swap"
+			gs.SplitForwardResults = []types.SplitForwardResultEntry{r}
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
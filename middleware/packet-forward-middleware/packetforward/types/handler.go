@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ForwardHandler delivers the funds of a forward to a local module, e.g. a swap router or an interchain
+// accounts controller, instead of transferring them to another chain. Handlers are registered with the
+// keeper under the name forward metadata selects them by with its handler key.
+type ForwardHandler interface {
+	// HandleForward is called once the funds of the inbound packet were received by sender, with the token
+	// to deliver, the forward metadata and the memo of its next key. The returned result is acknowledged to
+	// the inbound packet on success. Any error fails the forward, reverting the handler's state changes and
+	// refunding the inbound packet like a failed transfer hop.
+	HandleForward(
+		ctx sdk.Context,
+		inboundPacket channeltypes.Packet,
+		sender sdk.AccAddress,
+		token sdk.Coin,
+		metadata *ForwardMetadata,
+		memo string,
+	) (result []byte, err error)
+}
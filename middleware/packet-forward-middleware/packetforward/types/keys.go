@@ -1,6 +1,19 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -16,7 +29,121 @@ const (
 	QuerierRoute = ModuleName
 )
 
-var ParamsKey = []byte{0x00}
+var (
+	ParamsKey = []byte{0x00}
+
+	// RateLimitFlowPrefix prefixes the amount forwarded for each rate limit in
+	// its current window.
+	RateLimitFlowPrefix = []byte{0x02}
+
+	// ForceRefundedPacketPrefix prefixes the forwarded packets whose inbound packet
+	// was refunded by the authority before they were acknowledged or timed out.
+	ForceRefundedPacketPrefix = []byte{0x03}
+
+	// SplitForwardResultPrefix prefixes the outcomes of the completed forwards of an inbound
+	// packet split across several forwards, until all of them have completed.
+	SplitForwardResultPrefix = []byte{0x04}
+
+	// RecoveredFundsClaimPrefix prefixes the claims of the funds of failed nonrefundable
+	// forwards held in the claims escrow account, by original sender.
+	RecoveredFundsClaimPrefix = []byte{0x05}
+
+	// ClaimDelegatePrefix prefixes the accounts approved by the authority to claim the
+	// recovered funds of an original sender.
+	ClaimDelegatePrefix = []byte{0x06}
+
+	// NextRecoveredFundsClaimIDKey stores the id of the next recovered funds claim.
+	NextRecoveredFundsClaimIDKey = []byte{0x07}
+
+	// InFlightPacketPrefix prefixes the in-flight packets by the channel, port and sequence
+	// of the forwarded packet. They were stored under their raw channel/port/sequence keys
+	// before consensus version 3.
+	InFlightPacketPrefix = collections.NewPrefix(9)
+
+	// InFlightPacketInboundIndexPrefix prefixes the index of the in-flight packets by the
+	// channel, port and sequence of the inbound packet they were forwarded for.
+	InFlightPacketInboundIndexPrefix = collections.NewPrefix(10)
+
+	// InFlightPacketTimeoutTimestampIndexPrefix prefixes the index of the in-flight packets by the
+	// timeout timestamp of the inbound packet they were forwarded for.
+	InFlightPacketTimeoutTimestampIndexPrefix = collections.NewPrefix(11)
+
+	// InFlightPacketTimeoutHeightIndexPrefix prefixes the index of the in-flight packets by the
+	// revision height of the timeout height of the inbound packet they were forwarded for.
+	InFlightPacketTimeoutHeightIndexPrefix = collections.NewPrefix(12)
+
+	// TimeoutWatchdogTimestampKey stores the inbound timeout timestamp up to which the timeout
+	// watchdog has alerted of in-flight packets.
+	TimeoutWatchdogTimestampKey = collections.NewPrefix(13)
+
+	// TimeoutWatchdogHeightKey stores the inbound timeout height up to which the timeout
+	// watchdog has alerted of in-flight packets.
+	TimeoutWatchdogHeightKey = collections.NewPrefix(14)
+)
+
+// PacketKey identifies a packet by its channel, port and sequence.
+type PacketKey = collections.Triple[string, string, uint64]
+
+// PacketKeyCodec is the key codec of PacketKey.
+var PacketKeyCodec = collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+// InFlightPacketIndexes are the indexes of the in-flight packets.
+type InFlightPacketIndexes struct {
+	// Inbound indexes the in-flight packets by the inbound packet they were forwarded for.
+	Inbound *indexes.Multi[PacketKey, PacketKey, InFlightPacket]
+
+	// TimeoutTimestamp indexes the in-flight packets by the timeout timestamp of their inbound packet.
+	TimeoutTimestamp *indexes.Multi[uint64, PacketKey, InFlightPacket]
+
+	// TimeoutHeight indexes the in-flight packets by the revision height of the timeout height of their
+	// inbound packet. Packets whose inbound packet has no valid timeout height are indexed under zero.
+	TimeoutHeight *indexes.Multi[uint64, PacketKey, InFlightPacket]
+}
+
+// IndexesList implements collections.Indexes.
+func (i InFlightPacketIndexes) IndexesList() []collections.Index[PacketKey, InFlightPacket] {
+	return []collections.Index[PacketKey, InFlightPacket]{i.Inbound, i.TimeoutTimestamp, i.TimeoutHeight}
+}
+
+// NewInFlightPacketIndexes creates the indexes of the in-flight packets.
+func NewInFlightPacketIndexes(sb *collections.SchemaBuilder) InFlightPacketIndexes {
+	return InFlightPacketIndexes{
+		Inbound: indexes.NewMulti(
+			sb, InFlightPacketInboundIndexPrefix, "in_flight_packets_by_inbound_packet", PacketKeyCodec, PacketKeyCodec,
+			func(_ PacketKey, inFlightPacket InFlightPacket) (PacketKey, error) {
+				return collections.Join3(inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence), nil
+			},
+		),
+		TimeoutTimestamp: indexes.NewMulti(
+			sb, InFlightPacketTimeoutTimestampIndexPrefix, "in_flight_packets_by_inbound_timeout_timestamp", collections.Uint64Key, PacketKeyCodec,
+			func(_ PacketKey, inFlightPacket InFlightPacket) (uint64, error) {
+				return inFlightPacket.PacketTimeoutTimestamp, nil
+			},
+		),
+		TimeoutHeight: indexes.NewMulti(
+			sb, InFlightPacketTimeoutHeightIndexPrefix, "in_flight_packets_by_inbound_timeout_height", collections.Uint64Key, PacketKeyCodec,
+			func(_ PacketKey, inFlightPacket InFlightPacket) (uint64, error) {
+				height, err := clienttypes.ParseHeight(inFlightPacket.PacketTimeoutHeight)
+				if err != nil {
+					return 0, nil
+				}
+				return height.RevisionHeight, nil
+			},
+		),
+	}
+}
+
+// InFlightPackets is the collection of in-flight packets, keyed by the channel, port and sequence
+// of the forwarded packet.
+type InFlightPackets = collections.IndexedMap[PacketKey, InFlightPacket, InFlightPacketIndexes]
+
+// NewInFlightPackets creates the collection of in-flight packets and its indexes.
+func NewInFlightPackets(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) *InFlightPackets {
+	return collections.NewIndexedMap(
+		sb, InFlightPacketPrefix, "in_flight_packets", PacketKeyCodec,
+		codec.CollValue[InFlightPacket](cdc), NewInFlightPacketIndexes(sb),
+	)
+}
 
 type (
 	NonrefundableKey           struct{}
@@ -24,6 +151,76 @@ type (
 	ProcessedKey               struct{}
 )
 
+// RefundPacketKey returns the identifier of the forwarded packet with the given channel, port
+// and sequence, used in the keys of the module.
 func RefundPacketKey(channelID, portID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
 }
+
+// RateLimitFlowKey returns the key storing the amount forwarded for the rate
+// limit on the given outbound channel and denom.
+func RateLimitFlowKey(channelID, denom string) []byte {
+	key := append([]byte{}, RateLimitFlowPrefix...)
+	return append(key, fmt.Sprintf("%s/%s", channelID, denom)...)
+}
+
+// SplitForwardResultPrefixKey returns the prefix under which the outcomes of the completed
+// forwards of the given inbound packet are stored.
+func SplitForwardResultPrefixKey(inboundChannelID, inboundPortID string, inboundSequence uint64) []byte {
+	key := append([]byte{}, SplitForwardResultPrefix...)
+	key = append(key, RefundPacketKey(inboundChannelID, inboundPortID, inboundSequence)...)
+	return append(key, '/')
+}
+
+// SplitForwardResultKey returns the key storing the outcome of the forwarded packet on the given
+// channel, port and sequence under the inbound packet it was split from.
+func SplitForwardResultKey(
+	inboundChannelID, inboundPortID string, inboundSequence uint64,
+	channelID, portID string, sequence uint64,
+) []byte {
+	key := SplitForwardResultPrefixKey(inboundChannelID, inboundPortID, inboundSequence)
+	return append(key, RefundPacketKey(channelID, portID, sequence)...)
+}
+
+// ForceRefundedPacketKey returns the key marking the forwarded packet on the given
+// channel, port and sequence as refunded by the authority.
+func ForceRefundedPacketKey(channelID, portID string, sequence uint64) []byte {
+	key := append([]byte{}, ForceRefundedPacketPrefix...)
+	return append(key, RefundPacketKey(channelID, portID, sequence)...)
+}
+
+// RecoveredFundsClaimPrefixKey returns the prefix under which the recovered funds claims of
+// the given original sender are stored.
+func RecoveredFundsClaimPrefixKey(originalSender string) []byte {
+	key := append([]byte{}, RecoveredFundsClaimPrefix...)
+	return append(key, address.MustLengthPrefix([]byte(originalSender))...)
+}
+
+// RecoveredFundsClaimKey returns the key storing the recovered funds claim with the given id
+// of the given original sender.
+func RecoveredFundsClaimKey(originalSender string, id uint64) []byte {
+	return append(RecoveredFundsClaimPrefixKey(originalSender), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ClaimDelegateKey returns the key storing the account approved to claim the recovered funds
+// of the given original sender.
+func ClaimDelegateKey(originalSender string) []byte {
+	key := append([]byte{}, ClaimDelegatePrefix...)
+	return append(key, originalSender...)
+}
+
+// ParseRefundPacketKey parses an identifier created by RefundPacketKey back into its
+// channel, port and sequence.
+func ParseRefundPacketKey(key []byte) (channelID, portID string, sequence uint64, err error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("invalid refund packet key %q: expected 3 parts, got %d", key, len(parts))
+	}
+
+	sequence, err = strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid refund packet key %q: %w", key, err)
+	}
+
+	return parts[0], parts[1], sequence, nil
+}
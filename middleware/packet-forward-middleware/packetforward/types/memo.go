@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Hop is one forward of a multi-hop path: the chain that receives the packet forwards it on the port and
+// channel to the receiver on the next chain.
+type Hop struct {
+	Receiver string
+	Port     string
+	Channel  string
+
+	// Timeout, Retries and Backoff are optional and default to the settings of the forwarding chain.
+	Timeout time.Duration
+	Retries *uint8
+	Backoff *Backoff
+}
+
+// MemoBuilder composes the forward metadata of a multi-hop path into the memo of the packet sent to the
+// first hop, nesting the metadata of each hop in the next of the previous one.
+type MemoBuilder struct {
+	hops    []Hop
+	payload json.RawMessage
+}
+
+// NewMemoBuilder creates a new MemoBuilder for a path through the given hops.
+func NewMemoBuilder(hops ...Hop) *MemoBuilder {
+	return &MemoBuilder{hops: hops}
+}
+
+// AddHop appends a hop to the path.
+func (b *MemoBuilder) AddHop(hop Hop) *MemoBuilder {
+	b.hops = append(b.hops, hop)
+	return b
+}
+
+// WithPayload sets the memo of the packet delivered by the last hop, e.g. a wasm execute message. The
+// payload must be a JSON object.
+func (b *MemoBuilder) WithPayload(payload json.RawMessage) *MemoBuilder {
+	b.payload = payload
+	return b
+}
+
+// Metadata returns the validated packet metadata of the path.
+func (b *MemoBuilder) Metadata() (*PacketMetadata, error) {
+	if len(b.hops) == 0 {
+		return nil, errors.New("memo must forward through at least one hop")
+	}
+
+	var next *JSONObject
+	if len(b.payload) > 0 {
+		next = &JSONObject{}
+		if err := next.UnmarshalJSON(b.payload); err != nil || !next.obj {
+			return nil, errors.New("payload must be a JSON object")
+		}
+	}
+
+	var metadata *PacketMetadata
+	for i := len(b.hops) - 1; i >= 0; i-- {
+		if metadata != nil {
+			bz, err := json.Marshal(metadata)
+			if err != nil {
+				return nil, err
+			}
+			next = &JSONObject{}
+			if err := next.UnmarshalJSON(bz); err != nil {
+				return nil, err
+			}
+		}
+
+		hop := b.hops[i]
+		forward := &ForwardMetadata{
+			Receiver: hop.Receiver,
+			Port:     hop.Port,
+			Channel:  hop.Channel,
+			Timeout:  Duration(hop.Timeout),
+			Retries:  hop.Retries,
+			Backoff:  hop.Backoff,
+			Next:     next,
+		}
+		if err := forward.Validate(); err != nil {
+			return nil, fmt.Errorf("hop %d: %w", i, err)
+		}
+
+		metadata = &PacketMetadata{Forward: forward}
+	}
+
+	return metadata, nil
+}
+
+// Build returns the memo of the path.
+func (b *MemoBuilder) Build() (string, error) {
+	metadata, err := b.Metadata()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
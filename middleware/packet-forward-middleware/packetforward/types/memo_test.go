@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoBuilder(t *testing.T) {
+	retries := uint8(2)
+	memo, err := types.NewMemoBuilder(
+		types.Hop{Receiver: "pfm", Port: "transfer", Channel: "channel-0", Timeout: 10 * time.Minute, Retries: &retries},
+		types.Hop{Receiver: "pfm", Port: "transfer", Channel: "channel-1"},
+	).
+		AddHop(types.Hop{Receiver: "cosmos1dest", Port: "transfer", Channel: "channel-2", Backoff: &types.Backoff{Strategy: "linear"}}).
+		WithPayload(json.RawMessage(`{"wasm":{"contract":"cosmos1contract","msg":{"swap":{}}}}`)).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-0","timeout":600000000000,"retries":2,`+
+			`"next":{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-1",`+
+			`"next":{"forward":{"receiver":"cosmos1dest","port":"transfer","channel":"channel-2","backoff":{"strategy":"linear"},`+
+			`"next":{"wasm":{"contract":"cosmos1contract","msg":{"swap":{}}}}}}}}}}`,
+		memo,
+	)
+
+	// the memo of each hop is what the middleware forwards with.
+	var metadata types.PacketMetadata
+	require.NoError(t, json.Unmarshal([]byte(memo), &metadata))
+	require.NoError(t, metadata.Forward.Validate())
+	require.Equal(t, "channel-0", metadata.Forward.Channel)
+	require.Equal(t, types.Duration(10*time.Minute), metadata.Forward.Timeout)
+
+	next, err := json.Marshal(metadata.Forward.Next)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(next, &metadata))
+	require.Equal(t, "channel-1", metadata.Forward.Channel)
+}
+
+func TestMemoBuilderInvalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		builder *types.MemoBuilder
+	}{
+		{"no hops", types.NewMemoBuilder()},
+		{"invalid hop", types.NewMemoBuilder(types.Hop{Receiver: "pfm", Port: "transfer"})},
+		{"invalid backoff", types.NewMemoBuilder(types.Hop{Receiver: "pfm", Port: "transfer", Channel: "channel-0", Backoff: &types.Backoff{Strategy: "random"}})},
+		{"primitive payload", types.NewMemoBuilder(types.Hop{Receiver: "pfm", Port: "transfer", Channel: "channel-0"}).WithPayload(json.RawMessage(`"memo"`))},
+		{"invalid payload", types.NewMemoBuilder(types.Hop{Receiver: "pfm", Port: "transfer", Channel: "channel-0"}).WithPayload(json.RawMessage(`{"wasm":`))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			require.Error(t, err)
+		})
+	}
+}
@@ -1,12 +1,24 @@
 package types
 
 import (
+	"strings"
+
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+	_ sdk.Msg = &MsgForceRefundInFlightPacket{}
+	_ sdk.Msg = &MsgClearInFlightPacket{}
+	_ sdk.Msg = &MsgClaimRecoveredFunds{}
+	_ sdk.Msg = &MsgSetClaimDelegate{}
+)
 
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgUpdateParams) GetSignBytes() []byte {
@@ -27,3 +39,132 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetRateLimit message.
+func (m *MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgForceRefundInFlightPacket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgForceRefundInFlightPacket message.
+func (m *MsgForceRefundInFlightPacket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgForceRefundInFlightPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errors.Wrap(err, "invalid port id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgClearInFlightPacket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgClearInFlightPacket message.
+func (m *MsgClearInFlightPacket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgClearInFlightPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errors.Wrap(err, "invalid port id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgClaimRecoveredFunds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgClaimRecoveredFunds message.
+func (m *MsgClaimRecoveredFunds) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Claimer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgClaimRecoveredFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Claimer); err != nil {
+		return errors.Wrap(err, "invalid claimer address")
+	}
+	if strings.TrimSpace(m.OriginalSenderAddress) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "original sender address cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetClaimDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetClaimDelegate message.
+func (m *MsgSetClaimDelegate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetClaimDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if strings.TrimSpace(m.OriginalSenderAddress) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "original sender address cannot be empty")
+	}
+
+	if m.Delegate != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Delegate); err != nil {
+			return errors.Wrap(err, "invalid delegate address")
+		}
+	}
+
+	return nil
+}
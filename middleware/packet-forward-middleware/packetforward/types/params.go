@@ -2,13 +2,42 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultFeePercentage is the default value used to extract a fee from all forwarded packets.
 var DefaultFeePercentage = sdkmath.LegacyNewDec(0)
 
+const (
+	// DefaultMaxForwardDepth is the default maximum number of hops a received packet may be forwarded through.
+	DefaultMaxForwardDepth = 10
+
+	// DefaultMaxMemoSize is the default maximum size in bytes of the memo of a forwarded packet, the same as
+	// the maximum memo length of an ICS-20 MsgTransfer.
+	DefaultMaxMemoSize = 32768
+)
+
+const (
+	// WildcardChannel matches any channel in a ForwardRoute or FeeOverride.
+	WildcardChannel = "*"
+
+	// WildcardDenom matches any denom in a FeeOverride.
+	WildcardDenom = "*"
+)
+
+// moduleNameRegex matches module account names that may be used as a fee recipient.
+var moduleNameRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
 // NewParams creates a new parameter configuration for the pfm module.
 func NewParams(feePercentage sdkmath.LegacyDec) Params {
 	return Params{
@@ -18,12 +47,220 @@ func NewParams(feePercentage sdkmath.LegacyDec) Params {
 
 // DefaultParams is the default parameter configuration for the pfm module.
 func DefaultParams() Params {
-	return NewParams(DefaultFeePercentage)
+	params := NewParams(DefaultFeePercentage)
+	params.MaxForwardDepth = DefaultMaxForwardDepth
+	params.MaxMemoSize = DefaultMaxMemoSize
+	params.LoopDetection = LoopDetectionStrict
+	return params
 }
 
 // Validate the pfm module parameters.
 func (p Params) Validate() error {
-	return validateFeePercentage(p.FeePercentage)
+	if err := validateFeePercentage(p.FeePercentage); err != nil {
+		return err
+	}
+	if err := validateForwardRoutes(p.AllowedRoutes); err != nil {
+		return fmt.Errorf("invalid allowed routes: %w", err)
+	}
+	if err := validateForwardRoutes(p.DeniedRoutes); err != nil {
+		return fmt.Errorf("invalid denied routes: %w", err)
+	}
+	if err := validateFeeOverrides(p.FeeOverrides); err != nil {
+		return fmt.Errorf("invalid fee overrides: %w", err)
+	}
+	if err := validateFeeRecipient(p.FeeRecipient); err != nil {
+		return fmt.Errorf("invalid fee recipient: %w", err)
+	}
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return fmt.Errorf("invalid rate limits: %w", err)
+	}
+	if err := validateRelayerIncentives(p.RelayerIncentives); err != nil {
+		return fmt.Errorf("invalid relayer incentives: %w", err)
+	}
+	if err := p.RetryBackoff.Validate(); err != nil {
+		return fmt.Errorf("invalid retry backoff: %w", err)
+	}
+	if p.Timeouts != nil {
+		if err := p.Timeouts.Validate(); err != nil {
+			return fmt.Errorf("invalid timeouts: %w", err)
+		}
+	}
+	if p.TimeoutWatchdog != nil && p.TimeoutWatchdog.Window < 0 {
+		return fmt.Errorf("invalid timeout watchdog: window cannot be negative, got %s", p.TimeoutWatchdog.Window)
+	}
+	if _, ok := LoopDetection_name[int32(p.LoopDetection)]; !ok {
+		return fmt.Errorf("invalid loop detection %d", p.LoopDetection)
+	}
+
+	return nil
+}
+
+// IsForwardRouteAllowed returns true if packets received on the inbound channel may be
+// forwarded on the outbound channel. A route is allowed if it is not denied and either
+// no allowed routes are configured or it matches one of them.
+func (p Params) IsForwardRouteAllowed(inboundChannel, outboundChannel string) bool {
+	for _, route := range p.DeniedRoutes {
+		if route.Matches(inboundChannel, outboundChannel) {
+			return false
+		}
+	}
+
+	if len(p.AllowedRoutes) == 0 {
+		return true
+	}
+
+	for _, route := range p.AllowedRoutes {
+		if route.Matches(inboundChannel, outboundChannel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateMemoSize returns an error if the memo exceeds the maximum memo size, unless the memo clearly holds
+// no forward metadata: it neither contains the forward key nor escapes that could spell it. It is checked
+// before the memo is decoded, so that large memos are never unmarshaled.
+func (p Params) ValidateMemoSize(memo string) error {
+	if !strings.Contains(memo, "forward") && !strings.Contains(memo, `\`) {
+		return nil
+	}
+	if p.MaxMemoSize > 0 && len(memo) > int(p.MaxMemoSize) {
+		return errorsmod.Wrapf(ErrMemoTooLarge, "memo of %d bytes exceeds the maximum of %d bytes", len(memo), p.MaxMemoSize)
+	}
+	return nil
+}
+
+// ValidateForwardDepth returns an error if the forward metadata forwards a packet through more hops than the
+// maximum forward depth.
+func (p Params) ValidateForwardDepth(metadata *ForwardMetadata) error {
+	if p.MaxForwardDepth == 0 {
+		return nil
+	}
+
+	// the depth is only resolved up to one hop past the maximum.
+	if depth := metadata.depth(int(p.MaxForwardDepth) + 1); depth > int(p.MaxForwardDepth) {
+		return errorsmod.Wrapf(ErrForwardDepthExceeded, "forward exceeds the maximum depth of %d hops", p.MaxForwardDepth)
+	}
+	return nil
+}
+
+// ForwardFee returns the fee to take from a token forwarded on the given outbound channel.
+// The fee percentage and caps of the most specific matching FeeOverride are used if any,
+// otherwise the global fee percentage applies. The fee never exceeds the token amount.
+func (p Params) ForwardFee(channel string, token sdk.Coin) sdkmath.Int {
+	percentage := p.FeePercentage
+	minFee, maxFee := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	if override, found := p.feeOverride(channel, token.Denom); found {
+		percentage = override.FeePercentage
+		if !override.MinFee.IsNil() {
+			minFee = override.MinFee
+		}
+		if !override.MaxFee.IsNil() {
+			maxFee = override.MaxFee
+		}
+	}
+
+	fee := sdkmath.LegacyNewDecFromInt(token.Amount).Mul(percentage).RoundInt()
+	if fee.LT(minFee) {
+		fee = minFee
+	}
+	if maxFee.IsPositive() && fee.GT(maxFee) {
+		fee = maxFee
+	}
+
+	return sdkmath.MinInt(fee, token.Amount)
+}
+
+// ValidateForwardFee returns an error if the forward fee would consume the whole forwarded amount,
+// which would leave nothing to forward.
+func ValidateForwardFee(fee sdkmath.Int, token sdk.Coin) error {
+	if fee.IsPositive() && fee.GTE(token.Amount) {
+		return errorsmod.Wrapf(ErrForwardFeeTooHigh, "forward fee %s consumes the whole amount %s", fee, token)
+	}
+	return nil
+}
+
+// RelayerIncentive returns the relayer incentive for packets forwarded on the outbound channel, if any.
+func (p Params) RelayerIncentive(channel string) (RelayerIncentive, bool) {
+	for _, incentive := range p.RelayerIncentives {
+		if incentive.ChannelId == channel {
+			return incentive, true
+		}
+	}
+	return RelayerIncentive{}, false
+}
+
+// Fee returns the ICS-29 fee carved out of the forward fee by the incentive. Each share is rounded down, so
+// the fee never exceeds the forward fee.
+func (i RelayerIncentive) Fee(forwardFee sdk.Coin) feetypes.Fee {
+	share := func(share sdkmath.LegacyDec) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(forwardFee.Denom, sdkmath.LegacyNewDecFromInt(forwardFee.Amount).Mul(share).TruncateInt()))
+	}
+	return feetypes.NewFee(share(i.RecvFeeShare), share(i.AckFeeShare), share(i.TimeoutFeeShare))
+}
+
+// Validate asserts that the retries fit the retries of forward metadata and that the timeouts are positive.
+func (t Timeouts) Validate() error {
+	if t.RetriesOnTimeout > math.MaxUint8 {
+		return fmt.Errorf("retries on timeout %d exceeds the maximum of %d", t.RetriesOnTimeout, math.MaxUint8)
+	}
+	if t.ForwardTimeout <= 0 {
+		return fmt.Errorf("forward timeout must be positive, got %s", t.ForwardTimeout)
+	}
+	if t.RefundTimeout <= 0 {
+		return fmt.Errorf("refund timeout must be positive, got %s", t.RefundTimeout)
+	}
+	return nil
+}
+
+// RateLimit returns the rate limit for forwarding the denom on the outbound channel, if any.
+func (p Params) RateLimit(channel, denom string) (RateLimit, bool) {
+	for _, limit := range p.RateLimits {
+		if limit.ChannelId == channel && limit.Denom == denom {
+			return limit, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+// feeOverride returns the most specific FeeOverride matching the channel and denom.
+func (p Params) feeOverride(channel, denom string) (FeeOverride, bool) {
+	var (
+		match     FeeOverride
+		bestScore = -1
+	)
+	for _, override := range p.FeeOverrides {
+		if !override.Matches(channel, denom) {
+			continue
+		}
+
+		score := 0
+		if override.ChannelId != WildcardChannel {
+			score += 2
+		}
+		if override.Denom != WildcardDenom {
+			score++
+		}
+		if score > bestScore {
+			match, bestScore = override, score
+		}
+	}
+
+	return match, bestScore >= 0
+}
+
+// Matches returns true if the fee override applies to the outbound channel and denom.
+func (o FeeOverride) Matches(channel, denom string) bool {
+	return (o.ChannelId == WildcardChannel || o.ChannelId == channel) &&
+		(o.Denom == WildcardDenom || o.Denom == denom)
+}
+
+// Matches returns true if the route applies to forwarding from the inbound to the outbound channel.
+func (r ForwardRoute) Matches(inboundChannel, outboundChannel string) bool {
+	return (r.InboundChannelId == WildcardChannel || r.InboundChannelId == inboundChannel) &&
+		(r.OutboundChannelId == WildcardChannel || r.OutboundChannelId == outboundChannel)
 }
 
 // validateFeePercentage asserts that the fee percentage param is a valid sdk.Dec type.
@@ -41,3 +278,153 @@ func validateFeePercentage(i interface{}) error {
 
 	return nil
 }
+
+// validateForwardRoutes asserts that every route references a valid channel identifier or
+// the wildcard, and that no route is listed twice.
+func validateForwardRoutes(routes []ForwardRoute) error {
+	seen := make(map[ForwardRoute]bool, len(routes))
+	for _, route := range routes {
+		if err := validateRouteChannel(route.InboundChannelId); err != nil {
+			return err
+		}
+		if err := validateRouteChannel(route.OutboundChannelId); err != nil {
+			return err
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate route %s -> %s", route.InboundChannelId, route.OutboundChannelId)
+		}
+		seen[route] = true
+	}
+
+	return nil
+}
+
+func validateRouteChannel(channelID string) error {
+	if channelID == WildcardChannel {
+		return nil
+	}
+	return host.ChannelIdentifierValidator(channelID)
+}
+
+// validateFeeOverrides asserts that every override references a valid channel and denom,
+// has a valid fee percentage and caps, and that no channel and denom pair is listed twice.
+func validateFeeOverrides(overrides []FeeOverride) error {
+	type overrideKey struct{ channel, denom string }
+	seen := make(map[overrideKey]bool, len(overrides))
+	for _, override := range overrides {
+		if err := validateRouteChannel(override.ChannelId); err != nil {
+			return err
+		}
+		if override.Denom != WildcardDenom {
+			if err := sdk.ValidateDenom(override.Denom); err != nil {
+				return err
+			}
+		}
+		if err := validateFeePercentage(override.FeePercentage); err != nil {
+			return err
+		}
+
+		minFee, maxFee := override.MinFee, override.MaxFee
+		if minFee.IsNil() {
+			minFee = sdkmath.ZeroInt()
+		}
+		if maxFee.IsNil() {
+			maxFee = sdkmath.ZeroInt()
+		}
+		if minFee.IsNegative() || maxFee.IsNegative() {
+			return fmt.Errorf("fee caps for channel %s denom %s cannot be negative", override.ChannelId, override.Denom)
+		}
+		if (minFee.IsPositive() || maxFee.IsPositive()) && override.Denom == WildcardDenom {
+			return fmt.Errorf("fee caps for channel %s require a specific denom", override.ChannelId)
+		}
+		if maxFee.IsPositive() && minFee.GT(maxFee) {
+			return fmt.Errorf("min fee %s exceeds max fee %s for channel %s denom %s", minFee, maxFee, override.ChannelId, override.Denom)
+		}
+
+		key := overrideKey{override.ChannelId, override.Denom}
+		if seen[key] {
+			return fmt.Errorf("duplicate fee override for channel %s denom %s", override.ChannelId, override.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// validateFeeRecipient asserts that the fee recipient is empty, a valid account address
+// or a module account name.
+func validateFeeRecipient(recipient string) error {
+	if recipient == "" {
+		return nil
+	}
+	if strings.HasPrefix(recipient, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") {
+		_, err := sdk.AccAddressFromBech32(recipient)
+		return err
+	}
+	if !moduleNameRegex.MatchString(recipient) {
+		return fmt.Errorf("%s is neither an account address nor a module account name", recipient)
+	}
+
+	return nil
+}
+
+// validateRateLimits asserts that every rate limit references a valid channel and denom,
+// has a positive max amount and period, and that no channel and denom pair is listed twice.
+func validateRateLimits(limits []RateLimit) error {
+	type limitKey struct{ channel, denom string }
+	seen := make(map[limitKey]bool, len(limits))
+	for _, limit := range limits {
+		if err := host.ChannelIdentifierValidator(limit.ChannelId); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(limit.Denom); err != nil {
+			return err
+		}
+		if limit.MaxAmount.IsNil() || !limit.MaxAmount.IsPositive() {
+			return fmt.Errorf("max amount for channel %s denom %s must be positive", limit.ChannelId, limit.Denom)
+		}
+		if limit.Period <= 0 {
+			return fmt.Errorf("period for channel %s denom %s must be positive", limit.ChannelId, limit.Denom)
+		}
+
+		key := limitKey{limit.ChannelId, limit.Denom}
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for channel %s denom %s", limit.ChannelId, limit.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// validateRelayerIncentives asserts that every incentive references a valid channel and refund address, has
+// non-negative shares of the forward fee that add up to at most one, and that no channel is listed twice.
+func validateRelayerIncentives(incentives []RelayerIncentive) error {
+	seen := make(map[string]bool, len(incentives))
+	for _, incentive := range incentives {
+		if err := host.ChannelIdentifierValidator(incentive.ChannelId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(incentive.RefundAddress); err != nil {
+			return fmt.Errorf("invalid refund address for channel %s: %w", incentive.ChannelId, err)
+		}
+
+		total := sdkmath.LegacyZeroDec()
+		for _, share := range []sdkmath.LegacyDec{incentive.RecvFeeShare, incentive.AckFeeShare, incentive.TimeoutFeeShare} {
+			if share.IsNil() || share.IsNegative() {
+				return fmt.Errorf("fee shares for channel %s cannot be nil or negative", incentive.ChannelId)
+			}
+			total = total.Add(share)
+		}
+		if !total.IsPositive() || total.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("fee shares for channel %s must add up to more than 0 and at most 1, got %s", incentive.ChannelId, total)
+		}
+
+		if seen[incentive.ChannelId] {
+			return fmt.Errorf("duplicate relayer incentive for channel %s", incentive.ChannelId)
+		}
+		seen[incentive.ChannelId] = true
+	}
+
+	return nil
+}
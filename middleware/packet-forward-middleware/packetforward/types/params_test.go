@@ -0,0 +1,381 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidateForwardRoutes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		allowed []types.ForwardRoute
+		denied  []types.ForwardRoute
+		expErr  bool
+	}{
+		{"empty", nil, nil, false},
+		{"valid", []types.ForwardRoute{{"channel-0", "channel-1"}}, []types.ForwardRoute{{"*", "channel-2"}}, false},
+		{"invalid inbound channel", []types.ForwardRoute{{"", "channel-1"}}, nil, true},
+		{"invalid outbound channel", nil, []types.ForwardRoute{{"channel-0", "chan/1"}}, true},
+		{"duplicate route", []types.ForwardRoute{{"channel-0", "*"}, {"channel-0", "*"}}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.AllowedRoutes = tc.allowed
+			params.DeniedRoutes = tc.denied
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsIsForwardRouteAllowed(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsForwardRouteAllowed("channel-0", "channel-1"))
+
+	params.DeniedRoutes = []types.ForwardRoute{{"*", "channel-2"}}
+	require.True(t, params.IsForwardRouteAllowed("channel-0", "channel-1"))
+	require.False(t, params.IsForwardRouteAllowed("channel-0", "channel-2"))
+
+	params.AllowedRoutes = []types.ForwardRoute{{"channel-0", "*"}}
+	require.True(t, params.IsForwardRouteAllowed("channel-0", "channel-1"))
+	require.False(t, params.IsForwardRouteAllowed("channel-0", "channel-2"))
+	require.False(t, params.IsForwardRouteAllowed("channel-3", "channel-1"))
+}
+
+func TestParamsValidateFeeSchedule(t *testing.T) {
+	validOverride := func() types.FeeOverride {
+		return types.FeeOverride{
+			ChannelId:     "channel-0",
+			Denom:         "uatom",
+			FeePercentage: sdkmath.LegacyNewDecWithPrec(1, 2),
+			MinFee:        sdkmath.NewInt(1),
+			MaxFee:        sdkmath.NewInt(10),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		expErr   bool
+	}{
+		{"valid override", func(p *types.Params) { p.FeeOverrides = []types.FeeOverride{validOverride()} }, false},
+		{"wildcard channel", func(p *types.Params) {
+			o := validOverride()
+			o.ChannelId = types.WildcardChannel
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, false},
+		{"unset caps", func(p *types.Params) {
+			o := validOverride()
+			o.Denom = types.WildcardDenom
+			o.MinFee, o.MaxFee = sdkmath.Int{}, sdkmath.Int{}
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, false},
+		{"caps on wildcard denom", func(p *types.Params) {
+			o := validOverride()
+			o.Denom = types.WildcardDenom
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, true},
+		{"min exceeds max", func(p *types.Params) {
+			o := validOverride()
+			o.MinFee = sdkmath.NewInt(11)
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, true},
+		{"invalid percentage", func(p *types.Params) {
+			o := validOverride()
+			o.FeePercentage = sdkmath.LegacyNewDec(2)
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, true},
+		{"invalid denom", func(p *types.Params) {
+			o := validOverride()
+			o.Denom = "!"
+			p.FeeOverrides = []types.FeeOverride{o}
+		}, true},
+		{"duplicate override", func(p *types.Params) { p.FeeOverrides = []types.FeeOverride{validOverride(), validOverride()} }, true},
+		{"address recipient", func(p *types.Params) { p.FeeRecipient = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs" }, false},
+		{"module recipient", func(p *types.Params) { p.FeeRecipient = "fee_collector" }, false},
+		{"invalid recipient", func(p *types.Params) { p.FeeRecipient = "Fee Collector" }, true},
+		{"invalid address recipient", func(p *types.Params) { p.FeeRecipient = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhx" }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateForwardFee(t *testing.T) {
+	token := sdk.NewInt64Coin("uatom", 10)
+	require.NoError(t, types.ValidateForwardFee(sdkmath.ZeroInt(), token))
+	require.NoError(t, types.ValidateForwardFee(sdkmath.NewInt(9), token))
+	require.ErrorIs(t, types.ValidateForwardFee(sdkmath.NewInt(10), token), types.ErrForwardFeeTooHigh)
+}
+
+func TestParamsForwardFee(t *testing.T) {
+	params := types.NewParams(sdkmath.LegacyNewDecWithPrec(10, 2))
+	params.FeeOverrides = []types.FeeOverride{
+		{ChannelId: types.WildcardChannel, Denom: "uatom", FeePercentage: sdkmath.LegacyNewDecWithPrec(20, 2), MinFee: sdkmath.NewInt(5)},
+		{ChannelId: "channel-1", Denom: types.WildcardDenom, FeePercentage: sdkmath.LegacyNewDecWithPrec(30, 2)},
+		{ChannelId: "channel-1", Denom: "uatom", FeePercentage: sdkmath.LegacyNewDecWithPrec(40, 2), MaxFee: sdkmath.NewInt(30)},
+	}
+
+	testCases := []struct {
+		name     string
+		channel  string
+		token    sdk.Coin
+		expected int64
+	}{
+		{"global fee", "channel-0", sdk.NewInt64Coin("uosmo", 100), 10},
+		{"denom override", "channel-0", sdk.NewInt64Coin("uatom", 100), 20},
+		{"denom override min fee", "channel-0", sdk.NewInt64Coin("uatom", 10), 5},
+		{"min fee capped at amount", "channel-0", sdk.NewInt64Coin("uatom", 3), 3},
+		{"channel override", "channel-1", sdk.NewInt64Coin("uosmo", 100), 30},
+		{"channel and denom override", "channel-1", sdk.NewInt64Coin("uatom", 50), 20},
+		{"channel and denom override max fee", "channel-1", sdk.NewInt64Coin("uatom", 100), 30},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdkmath.NewInt(tc.expected), params.ForwardFee(tc.channel, tc.token))
+		})
+	}
+}
+
+func TestParamsValidateRateLimits(t *testing.T) {
+	validLimit := func() types.RateLimit {
+		return types.RateLimit{
+			ChannelId: "channel-0",
+			Denom:     "uatom",
+			MaxAmount: sdkmath.NewInt(1000),
+			Period:    time.Hour,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.RateLimit)
+		limits   int
+		expErr   bool
+	}{
+		{"valid", func(*types.RateLimit) {}, 1, false},
+		{"wildcard channel", func(l *types.RateLimit) { l.ChannelId = types.WildcardChannel }, 1, true},
+		{"invalid denom", func(l *types.RateLimit) { l.Denom = "!" }, 1, true},
+		{"unset max amount", func(l *types.RateLimit) { l.MaxAmount = sdkmath.Int{} }, 1, true},
+		{"zero max amount", func(l *types.RateLimit) { l.MaxAmount = sdkmath.ZeroInt() }, 1, true},
+		{"zero period", func(l *types.RateLimit) { l.Period = 0 }, 1, true},
+		{"duplicate", func(*types.RateLimit) {}, 2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit := validLimit()
+			tc.malleate(&limit)
+
+			params := types.DefaultParams()
+			for i := 0; i < tc.limits; i++ {
+				params.RateLimits = append(params.RateLimits, limit)
+			}
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateRelayerIncentives(t *testing.T) {
+	validIncentive := func() types.RelayerIncentive {
+		return types.RelayerIncentive{
+			ChannelId:       "channel-0",
+			RecvFeeShare:    sdkmath.LegacyNewDecWithPrec(5, 1),
+			AckFeeShare:     sdkmath.LegacyNewDecWithPrec(3, 1),
+			TimeoutFeeShare: sdkmath.LegacyNewDecWithPrec(2, 1),
+			RefundAddress:   sdk.AccAddress("refund").String(),
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(*types.RelayerIncentive)
+		incentives int
+		expErr     bool
+	}{
+		{"valid", func(*types.RelayerIncentive) {}, 1, false},
+		{"wildcard channel", func(i *types.RelayerIncentive) { i.ChannelId = types.WildcardChannel }, 1, true},
+		{"invalid refund address", func(i *types.RelayerIncentive) { i.RefundAddress = "cosmos1invalid" }, 1, true},
+		{"unset share", func(i *types.RelayerIncentive) { i.AckFeeShare = sdkmath.LegacyDec{} }, 1, true},
+		{"negative share", func(i *types.RelayerIncentive) { i.AckFeeShare = sdkmath.LegacyNewDec(-1) }, 1, true},
+		{"shares above one", func(i *types.RelayerIncentive) { i.RecvFeeShare = sdkmath.LegacyNewDecWithPrec(6, 1) }, 1, true},
+		{"zero shares", func(i *types.RelayerIncentive) {
+			i.RecvFeeShare, i.AckFeeShare, i.TimeoutFeeShare = sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+		}, 1, true},
+		{"duplicate", func(*types.RelayerIncentive) {}, 2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			incentive := validIncentive()
+			tc.malleate(&incentive)
+
+			params := types.DefaultParams()
+			for i := 0; i < tc.incentives; i++ {
+				params.RelayerIncentives = append(params.RelayerIncentives, incentive)
+			}
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRelayerIncentiveFee(t *testing.T) {
+	incentive := types.RelayerIncentive{
+		RecvFeeShare:    sdkmath.LegacyNewDecWithPrec(5, 1),
+		AckFeeShare:     sdkmath.LegacyNewDecWithPrec(25, 2),
+		TimeoutFeeShare: sdkmath.LegacyZeroDec(),
+	}
+
+	// shares are rounded down and empty fees are left out.
+	fee := incentive.Fee(sdk.NewInt64Coin("uatom", 7))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3)), fee.RecvFee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), fee.AckFee)
+	require.True(t, fee.TimeoutFee.IsZero())
+}
+
+func TestParamsValidateTimeouts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		timeouts *types.Timeouts
+		expErr   bool
+	}{
+		{"unset", nil, false},
+		{"valid", &types.Timeouts{RetriesOnTimeout: 255, ForwardTimeout: time.Minute, RefundTimeout: time.Hour}, false},
+		{"retries above uint8", &types.Timeouts{RetriesOnTimeout: 256, ForwardTimeout: time.Minute, RefundTimeout: time.Hour}, true},
+		{"zero forward timeout", &types.Timeouts{RefundTimeout: time.Hour}, true},
+		{"negative refund timeout", &types.Timeouts{ForwardTimeout: time.Minute, RefundTimeout: -time.Hour}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.Timeouts = tc.timeouts
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateTimeoutWatchdog(t *testing.T) {
+	params := types.DefaultParams()
+	params.TimeoutWatchdog = &types.TimeoutWatchdog{HeightWindow: 10}
+	require.NoError(t, params.Validate())
+
+	params.TimeoutWatchdog.Window = -time.Minute
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidateMemoSize(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxMemoSize = 16
+
+	require.NoError(t, params.ValidateMemoSize(`{"forward":{}}`+strings.Repeat(" ", 2)))
+	require.ErrorIs(t, params.ValidateMemoSize(`{"forward":{}}`+strings.Repeat(" ", 3)), types.ErrMemoTooLarge)
+
+	// memos that may spell the forward key with escapes are limited too.
+	require.ErrorIs(t, params.ValidateMemoSize(`{"\u0066orward":{}}`), types.ErrMemoTooLarge)
+
+	// memos that clearly hold no forward metadata are left to the underlying application.
+	require.NoError(t, params.ValidateMemoSize(`{"wasm":"`+strings.Repeat("a", 1<<20)+`"}`))
+
+	params.MaxMemoSize = 0
+	require.NoError(t, params.ValidateMemoSize(`{"forward":"`+strings.Repeat("a", 1<<20)+`"}`))
+}
+
+func TestParamsValidateForwardDepth(t *testing.T) {
+	// nestedMemo returns a memo forwarding through the given number of hops.
+	nestedMemo := func(hops int) string {
+		hop := `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":`
+		return strings.Repeat(hop, hops-1) + `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0"}}` + strings.Repeat("}}", hops-1)
+	}
+
+	testCases := []struct {
+		name   string
+		memo   string
+		max    uint32
+		expErr bool
+	}{
+		{"single hop", nestedMemo(1), 1, false},
+		{"at maximum depth", nestedMemo(3), 3, false},
+		{"past maximum depth", nestedMemo(4), 3, true},
+		{"pathological depth", nestedMemo(1000), 10, true},
+		{"unlimited depth", nestedMemo(1000), 0, false},
+		{"next without forward", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":{"wasm":{}}}}`, 1, false},
+		{"string next", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":"{\"forward\":{\"receiver\":\"cosmos1\",\"port\":\"transfer\",\"channel\":\"channel-0\"}}"}}`, 1, true},
+		{"split within maximum depth", `{"forward":{"splits":[` + nestedMemo(2)[len(`{"forward":`):len(nestedMemo(2))-1] + `,{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}]}}`, 2, false},
+		{"split past maximum depth", `{"forward":{"splits":[` + nestedMemo(3)[len(`{"forward":`):len(nestedMemo(3))-1] + `,{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}]}}`, 2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxForwardDepth = tc.max
+
+			var metadata types.PacketMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.memo), &metadata))
+
+			err := params.ValidateForwardDepth(metadata.Forward)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrForwardDepthExceeded)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateLoopDetection(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.LoopDetectionStrict, params.LoopDetection)
+
+	params.LoopDetection = types.LoopDetectionAllow
+	require.NoError(t, params.Validate())
+
+	params.LoopDetection = types.LoopDetection(2)
+	require.Error(t, params.Validate())
+}
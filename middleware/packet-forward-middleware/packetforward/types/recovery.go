@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// RecoveryAddressResolver resolves the account on this chain that receives the funds of a forward that failed
+// after the packet could no longer be refunded, e.g. because its funds were swapped by another middleware.
+// Chains with other address formats or recovery policies can provide their own resolver with
+// Keeper.SetRecoveryAddressResolver.
+type RecoveryAddressResolver interface {
+	ResolveRecoveryAddress(ctx sdk.Context, inFlightPacket *InFlightPacket) (sdk.AccAddress, error)
+}
+
+// DefaultRecoveryAddressResolver resolves the recover address of the forward metadata if set and valid, otherwise the
+// receiver of the original packet if it is a valid bech32 address for this chain, otherwise the sender of the
+// original packet translated to this chain. Note that for the fallback, the coin type of the source chain
+// sender account must be compatible with this chain.
+type DefaultRecoveryAddressResolver struct{}
+
+var _ RecoveryAddressResolver = DefaultRecoveryAddressResolver{}
+
+// ResolveRecoveryAddress implements RecoveryAddressResolver.
+func (DefaultRecoveryAddressResolver) ResolveRecoveryAddress(_ sdk.Context, inFlightPacket *InFlightPacket) (sdk.AccAddress, error) {
+	// the recover address is validated when the packet is received, but failing here would leave the funds
+	// stuck, as the acknowledgement or timeout of the forwarded packet could never be relayed.
+	if recoverAddress, err := sdk.AccAddressFromBech32(inFlightPacket.RecoverAddress); err == nil {
+		return recoverAddress, nil
+	}
+
+	var originalData transfertypes.FungibleTokenPacketData
+	err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &originalData)
+	if err == nil {
+		receiver, err := sdk.AccAddressFromBech32(originalData.Receiver)
+		if err == nil {
+			return receiver, nil
+		}
+	}
+
+	_, sender, fallbackErr := bech32.DecodeAndConvert(inFlightPacket.OriginalSenderAddress)
+	if fallbackErr == nil {
+		return sender, nil
+	}
+
+	return nil, fmt.Errorf("failed to decode bech32 addresses: %w", errors.Join(err, fallbackErr))
+}
+
+// ClaimsEscrowAddress returns the account holding the funds of failed nonrefundable forwards until they are
+// claimed, if Params.ClaimableRecovery is set.
+func ClaimsEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("claims"))
+}
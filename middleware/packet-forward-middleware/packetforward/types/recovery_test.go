@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestDefaultRecoveryAddressResolver(t *testing.T) {
+	const (
+		receiver       = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		recoverAddress = "cosmos1v954djef63x2lqj8yy7r3r487heg0exdmkj0sr"
+		sender         = "osmo1wnlew8ss0sqclfalvj6jkcyvnwq79fd7am4k2t"
+	)
+
+	packetData := func(receiver string) []byte {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver, "")
+		return transfertypes.ModuleCdc.MustMarshalJSON(&data)
+	}
+
+	testCases := []struct {
+		name           string
+		recoverAddress string
+		receiver       string
+		expected       string
+		expErr         bool
+	}{
+		{"recover address", recoverAddress, receiver, recoverAddress, false},
+		{"invalid recover address", "0x6ab3a38c6a9b7d5cc4c1cd4b0e1d1e2a3f5a9d7c", receiver, receiver, false},
+		{"original receiver", "", receiver, receiver, false},
+		{"original sender", "", "0x6ab3a38c6a9b7d5cc4c1cd4b0e1d1e2a3f5a9d7c", "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inFlightPacket := &types.InFlightPacket{
+				OriginalSenderAddress: sender,
+				PacketData:            packetData(tc.receiver),
+				RecoverAddress:        tc.recoverAddress,
+			}
+
+			address, err := types.DefaultRecoveryAddressResolver{}.ResolveRecoveryAddress(sdk.Context{}, inFlightPacket)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, address.String())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types (interfaces: FeeKeeper)
+//
+// Generated by this command:
+//
+//	mockgen -package=mock -destination=./test/mock/fee_keeper.go github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types FeeKeeper
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeKeeper is a mock of FeeKeeper interface.
+type MockFeeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeKeeperMockRecorder
+}
+
+// MockFeeKeeperMockRecorder is the mock recorder for MockFeeKeeper.
+type MockFeeKeeperMockRecorder struct {
+	mock *MockFeeKeeper
+}
+
+// NewMockFeeKeeper creates a new mock instance.
+func NewMockFeeKeeper(ctrl *gomock.Controller) *MockFeeKeeper {
+	mock := &MockFeeKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeKeeper) EXPECT() *MockFeeKeeperMockRecorder {
+	return m.recorder
+}
+
+// IsFeeEnabled mocks base method.
+func (m *MockFeeKeeper) IsFeeEnabled(arg0 types.Context, arg1, arg2 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFeeEnabled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsFeeEnabled indicates an expected call of IsFeeEnabled.
+func (mr *MockFeeKeeperMockRecorder) IsFeeEnabled(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFeeEnabled", reflect.TypeOf((*MockFeeKeeper)(nil).IsFeeEnabled), arg0, arg1, arg2)
+}
+
+// PayPacketFeeAsync mocks base method.
+func (m *MockFeeKeeper) PayPacketFeeAsync(arg0 context.Context, arg1 *types0.MsgPayPacketFeeAsync) (*types0.MsgPayPacketFeeAsyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayPacketFeeAsync", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgPayPacketFeeAsyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayPacketFeeAsync indicates an expected call of PayPacketFeeAsync.
+func (mr *MockFeeKeeperMockRecorder) PayPacketFeeAsync(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayPacketFeeAsync", reflect.TypeOf((*MockFeeKeeper)(nil).PayPacketFeeAsync), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/ics721 (interfaces: NFTTransferKeeper)
+//
+// Generated by this command:
+//
+//	mockgen -package=mock -destination=./test/mock/nft_transfer_keeper.go github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/ics721 NFTTransferKeeper
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNFTTransferKeeper is a mock of NFTTransferKeeper interface.
+type MockNFTTransferKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockNFTTransferKeeperMockRecorder
+}
+
+// MockNFTTransferKeeperMockRecorder is the mock recorder for MockNFTTransferKeeper.
+type MockNFTTransferKeeperMockRecorder struct {
+	mock *MockNFTTransferKeeper
+}
+
+// NewMockNFTTransferKeeper creates a new mock instance.
+func NewMockNFTTransferKeeper(ctrl *gomock.Controller) *MockNFTTransferKeeper {
+	mock := &MockNFTTransferKeeper{ctrl: ctrl}
+	mock.recorder = &MockNFTTransferKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNFTTransferKeeper) EXPECT() *MockNFTTransferKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendTransfer mocks base method.
+func (m *MockNFTTransferKeeper) SendTransfer(arg0 types.Context, arg1, arg2, arg3 string, arg4 []string, arg5 types.AccAddress, arg6 string, arg7 types0.Height, arg8 uint64, arg9 string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransfer", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransfer indicates an expected call of SendTransfer.
+func (mr *MockNFTTransferKeeperMockRecorder) SendTransfer(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransfer", reflect.TypeOf((*MockNFTTransferKeeper)(nil).SendTransfer), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types (interfaces: TransferKeeper)
+//
+// Generated by this command:
+//
+//	mockgen -package=mock -destination=./test/mock/transfer_keeper.go github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types TransferKeeper
+//
 
 // Package mock is a generated GoMock package.
 package mock
@@ -46,11 +51,39 @@ func (m *MockTransferKeeper) DenomPathFromHash(arg0 types.Context, arg1 string)
 }
 
 // DenomPathFromHash indicates an expected call of DenomPathFromHash.
-func (mr *MockTransferKeeperMockRecorder) DenomPathFromHash(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTransferKeeperMockRecorder) DenomPathFromHash(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomPathFromHash", reflect.TypeOf((*MockTransferKeeper)(nil).DenomPathFromHash), arg0, arg1)
 }
 
+// GetAllTotalEscrowed mocks base method.
+func (m *MockTransferKeeper) GetAllTotalEscrowed(arg0 types.Context) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTotalEscrowed", arg0)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetAllTotalEscrowed indicates an expected call of GetAllTotalEscrowed.
+func (mr *MockTransferKeeperMockRecorder) GetAllTotalEscrowed(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTotalEscrowed", reflect.TypeOf((*MockTransferKeeper)(nil).GetAllTotalEscrowed), arg0)
+}
+
+// GetPort mocks base method.
+func (m *MockTransferKeeper) GetPort(arg0 types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPort", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPort indicates an expected call of GetPort.
+func (mr *MockTransferKeeperMockRecorder) GetPort(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockTransferKeeper)(nil).GetPort), arg0)
+}
+
 // GetTotalEscrowForDenom mocks base method.
 func (m *MockTransferKeeper) GetTotalEscrowForDenom(arg0 types.Context, arg1 string) types.Coin {
 	m.ctrl.T.Helper()
@@ -60,7 +93,7 @@ func (m *MockTransferKeeper) GetTotalEscrowForDenom(arg0 types.Context, arg1 str
 }
 
 // GetTotalEscrowForDenom indicates an expected call of GetTotalEscrowForDenom.
-func (mr *MockTransferKeeperMockRecorder) GetTotalEscrowForDenom(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTransferKeeperMockRecorder) GetTotalEscrowForDenom(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalEscrowForDenom", reflect.TypeOf((*MockTransferKeeper)(nil).GetTotalEscrowForDenom), arg0, arg1)
 }
@@ -72,7 +105,7 @@ func (m *MockTransferKeeper) SetTotalEscrowForDenom(arg0 types.Context, arg1 typ
 }
 
 // SetTotalEscrowForDenom indicates an expected call of SetTotalEscrowForDenom.
-func (mr *MockTransferKeeperMockRecorder) SetTotalEscrowForDenom(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTransferKeeperMockRecorder) SetTotalEscrowForDenom(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTotalEscrowForDenom", reflect.TypeOf((*MockTransferKeeper)(nil).SetTotalEscrowForDenom), arg0, arg1)
 }
@@ -87,7 +120,7 @@ func (m *MockTransferKeeper) Transfer(arg0 context.Context, arg1 *types0.MsgTran
 }
 
 // Transfer indicates an expected call of Transfer.
-func (mr *MockTransferKeeperMockRecorder) Transfer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTransferKeeperMockRecorder) Transfer(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferKeeper)(nil).Transfer), arg0, arg1)
 }
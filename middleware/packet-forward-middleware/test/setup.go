@@ -25,7 +25,10 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
 func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
@@ -36,19 +39,22 @@ func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
 	channelKeeperMock := mock.NewMockChannelKeeper(ctl)
 	distributionKeeperMock := mock.NewMockDistributionKeeper(ctl)
 	bankKeeperMock := mock.NewMockBankKeeper(ctl)
+	feeKeeperMock := mock.NewMockFeeKeeper(ctl)
 	ibcModuleMock := mock.NewMockIBCModule(ctl)
 	ics4WrapperMock := mock.NewMockICS4Wrapper(ctl)
 
 	paramsKeeper := initializer.paramsKeeper()
 	packetforwardKeeper := initializer.packetforwardKeeper(paramsKeeper, transferKeeperMock, channelKeeperMock, distributionKeeperMock, bankKeeperMock, ics4WrapperMock)
 
+	packetforwardKeeper.SetFeeKeeper(feeKeeperMock)
+
 	require.NoError(t, initializer.StateStore.LoadLatestVersion())
 
 	if err := packetforwardKeeper.SetParams(initializer.Ctx, types.DefaultParams()); err != nil {
 		t.Fatal(err)
 	}
 
-	return &Setup{
+	setup := &Setup{
 		Initializer: initializer,
 
 		Keepers: &testKeepers{
@@ -58,13 +64,30 @@ func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
 
 		Mocks: &testMocks{
 			TransferKeeperMock:     transferKeeperMock,
+			ChannelKeeperMock:      channelKeeperMock,
 			DistributionKeeperMock: distributionKeeperMock,
+			BankKeeperMock:         bankKeeperMock,
+			FeeKeeperMock:          feeKeeperMock,
 			IBCModuleMock:          ibcModuleMock,
 			ICS4WrapperMock:        ics4WrapperMock,
 		},
 
 		ForwardMiddleware: initializer.forwardMiddleware(ibcModuleMock, packetforwardKeeper, 0, keeper.DefaultForwardTransferPacketTimeoutTimestamp, keeper.DefaultRefundTransferPacketTimeoutTimestamp),
+
+		ChainIDs:   map[string]string{},
 	}
+
+	channelKeeperMock.EXPECT().GetChannelClientState(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _, channel string) (string, ibcexported.ClientState, error) {
+			chainID, found := setup.ChainIDs[channel]
+			if !found {
+				return "", nil, clienttypes.ErrClientNotFound
+			}
+			return "07-tendermint-0", &ibctm.ClientState{ChainId: chainID}, nil
+		}).
+		AnyTimes()
+
+	return setup
 }
 
 type Setup struct {
@@ -74,6 +97,9 @@ type Setup struct {
 	Mocks   *testMocks
 
 	ForwardMiddleware packetforward.IBCMiddleware
+
+	// ChainIDs are the counterparty chain ids of channels connected through a tendermint light client.
+	ChainIDs map[string]string
 }
 
 type testKeepers struct {
@@ -83,7 +109,10 @@ type testKeepers struct {
 
 type testMocks struct {
 	TransferKeeperMock     *mock.MockTransferKeeper
+	ChannelKeeperMock      *mock.MockChannelKeeper
 	DistributionKeeperMock *mock.MockDistributionKeeper
+	BankKeeperMock         *mock.MockBankKeeper
+	FeeKeeperMock          *mock.MockFeeKeeper
 	IBCModuleMock          *mock.MockIBCModule
 	ICS4WrapperMock        *mock.MockICS4Wrapper
 }
@@ -531,6 +531,7 @@ func NewSimApp(
 	)
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
+	app.PacketForwardKeeper.SetFeeKeeper(app.IBCFeeKeeper)
 
 	// Create Transfer Stack
 	var transferStack ibcporttypes.IBCModule